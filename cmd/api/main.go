@@ -42,5 +42,9 @@ func main() {
 	}
 
 	mux := app.Mount()
-	log.Fatal().Err(app.Run(mux)).Msg("Server has stopped")
+	if err := app.Run(mux); err != nil {
+		log.Fatal().Err(err).Msg("Server has stopped")
+	}
+
+	log.Info().Msg("Server has stopped")
 }
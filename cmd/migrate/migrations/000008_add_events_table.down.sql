@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_events_updated_at ON events;
+
+DROP TABLE IF EXISTS events;
\ No newline at end of file
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS events (
+    id BIGSERIAL PRIMARY KEY,
+    org_id BIGINT NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    start_time TIMESTAMP(0) WITH TIME ZONE NOT NULL,
+    end_time TIMESTAMP(0) WITH TIME ZONE NOT NULL,
+    location VARCHAR(255) NOT NULL DEFAULT '',
+    online_url VARCHAR(255) NOT NULL DEFAULT '',
+    capacity INT NOT NULL,
+    version BIGINT DEFAULT 0,
+    created_at TIMESTAMP(0) WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP(0) WITH TIME ZONE DEFAULT NULL,
+    deleted_at TIMESTAMP(0) WITH TIME ZONE DEFAULT NULL,
+
+    CONSTRAINT fk_org FOREIGN KEY (org_id) REFERENCES organizations (id),
+    CONSTRAINT chk_event_times CHECK (end_time > start_time),
+    CONSTRAINT chk_event_capacity CHECK (capacity > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_events_org_id_start_time ON events (org_id, start_time);
+
+CREATE TRIGGER update_events_updated_at BEFORE UPDATE
+ON events FOR EACH ROW EXECUTE PROCEDURE 
+update_updated_at_column();
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE events
+    DROP CONSTRAINT IF EXISTS fk_published_by,
+    DROP COLUMN IF EXISTS published_by,
+    DROP COLUMN IF EXISTS published_at;
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE events
+    ADD COLUMN IF NOT EXISTS published_at TIMESTAMP(0) WITH TIME ZONE DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS published_by BIGINT DEFAULT NULL,
+    ADD CONSTRAINT fk_published_by FOREIGN KEY (published_by) REFERENCES user_profiles (id);
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE events
+    ADD COLUMN IF NOT EXISTS cancelled_at TIMESTAMP(0) WITH TIME ZONE DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS cancellation_reason TEXT NOT NULL DEFAULT '';
\ No newline at end of file
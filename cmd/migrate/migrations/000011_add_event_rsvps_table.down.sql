@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_event_rsvps_updated_at ON event_rsvps;
+
+DROP TABLE IF EXISTS event_rsvps;
\ No newline at end of file
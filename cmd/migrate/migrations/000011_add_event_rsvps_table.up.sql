@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS event_rsvps (
+    id BIGSERIAL PRIMARY KEY,
+    event_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    version BIGINT DEFAULT 0,
+    created_at TIMESTAMP(0) WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP(0) WITH TIME ZONE DEFAULT NULL,
+    deleted_at TIMESTAMP(0) WITH TIME ZONE DEFAULT NULL,
+
+    CONSTRAINT fk_event FOREIGN KEY (event_id) REFERENCES events (id),
+    CONSTRAINT fk_user_profile FOREIGN KEY (user_id) REFERENCES user_profiles (id),
+    CONSTRAINT uq_event_rsvps_event_user UNIQUE (event_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_rsvps_event_id_status ON event_rsvps (event_id, status);
+
+CREATE TRIGGER update_event_rsvps_updated_at BEFORE UPDATE
+ON event_rsvps FOR EACH ROW EXECUTE PROCEDURE 
+update_updated_at_column();
\ No newline at end of file
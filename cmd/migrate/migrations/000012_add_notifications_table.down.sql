@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_notifications_updated_at ON notifications;
+
+DROP TABLE IF EXISTS notifications;
\ No newline at end of file
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS notifications (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    event_id BIGINT DEFAULT NULL,
+    type VARCHAR(50) NOT NULL,
+    message TEXT NOT NULL,
+    read_at TIMESTAMP(0) WITH TIME ZONE DEFAULT NULL,
+    version BIGINT DEFAULT 0,
+    created_at TIMESTAMP(0) WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP(0) WITH TIME ZONE DEFAULT NULL,
+    deleted_at TIMESTAMP(0) WITH TIME ZONE DEFAULT NULL,
+
+    CONSTRAINT fk_user_profile FOREIGN KEY (user_id) REFERENCES user_profiles (id),
+    CONSTRAINT fk_event FOREIGN KEY (event_id) REFERENCES events (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user_id_created_at ON notifications (user_id, created_at);
+
+CREATE TRIGGER update_notifications_updated_at BEFORE UPDATE
+ON notifications FOR EACH ROW EXECUTE PROCEDURE 
+update_updated_at_column();
\ No newline at end of file
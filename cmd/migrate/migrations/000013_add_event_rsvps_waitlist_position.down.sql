@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_event_rsvps_event_id_waitlist_position;
+
+ALTER TABLE event_rsvps
+    DROP COLUMN IF EXISTS waitlist_position;
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE event_rsvps
+    ADD COLUMN IF NOT EXISTS waitlist_position INT DEFAULT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_event_rsvps_event_id_waitlist_position ON event_rsvps (event_id, waitlist_position);
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE events
+    DROP COLUMN IF EXISTS exdates,
+    DROP COLUMN IF EXISTS rrule;
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_event_occurrence_overrides_updated_at ON event_occurrence_overrides;
+
+DROP TABLE IF EXISTS event_occurrence_overrides;
\ No newline at end of file
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS event_occurrence_overrides (
+    id BIGSERIAL PRIMARY KEY,
+    event_id BIGINT NOT NULL,
+    occurrence_start TIMESTAMP(0) WITH TIME ZONE NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    start_time TIMESTAMP(0) WITH TIME ZONE NOT NULL,
+    end_time TIMESTAMP(0) WITH TIME ZONE NOT NULL,
+    location VARCHAR(255) NOT NULL DEFAULT '',
+    online_url VARCHAR(255) NOT NULL DEFAULT '',
+    capacity INT NOT NULL,
+    version BIGINT DEFAULT 0,
+    created_at TIMESTAMP(0) WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP(0) WITH TIME ZONE DEFAULT NULL,
+    deleted_at TIMESTAMP(0) WITH TIME ZONE DEFAULT NULL,
+
+    CONSTRAINT fk_event FOREIGN KEY (event_id) REFERENCES events (id),
+    CONSTRAINT uq_event_occurrence_overrides_event_occurrence UNIQUE (event_id, occurrence_start),
+    CONSTRAINT chk_event_occurrence_times CHECK (end_time > start_time),
+    CONSTRAINT chk_event_occurrence_capacity CHECK (capacity > 0)
+);
+
+CREATE TRIGGER update_event_occurrence_overrides_updated_at BEFORE UPDATE
+ON event_occurrence_overrides FOR EACH ROW EXECUTE PROCEDURE 
+update_updated_at_column();
\ No newline at end of file
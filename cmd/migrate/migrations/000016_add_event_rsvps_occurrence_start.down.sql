@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS uq_event_rsvps_event_user_occurrence;
+
+DELETE FROM event_rsvps WHERE occurrence_start IS NOT NULL;
+
+ALTER TABLE event_rsvps
+    DROP COLUMN IF EXISTS occurrence_start,
+    ADD CONSTRAINT uq_event_rsvps_event_user UNIQUE (event_id, user_id);
\ No newline at end of file
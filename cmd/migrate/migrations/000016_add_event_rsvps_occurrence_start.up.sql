@@ -0,0 +1,8 @@
+ALTER TABLE event_rsvps
+    ADD COLUMN IF NOT EXISTS occurrence_start TIMESTAMP(0) WITH TIME ZONE DEFAULT NULL,
+    DROP CONSTRAINT IF EXISTS uq_event_rsvps_event_user;
+
+-- Non recurring events have no occurrence_start, and NULLs are never equal
+-- in a unique constraint so they are mapped to -infinity instead.
+CREATE UNIQUE INDEX IF NOT EXISTS uq_event_rsvps_event_user_occurrence
+ON event_rsvps (event_id, user_id, COALESCE(occurrence_start, '-infinity'::TIMESTAMPTZ));
\ No newline at end of file
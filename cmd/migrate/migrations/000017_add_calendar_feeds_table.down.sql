@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_calendar_feeds_updated_at ON calendar_feeds;
+
+DROP TABLE IF EXISTS calendar_feeds;
\ No newline at end of file
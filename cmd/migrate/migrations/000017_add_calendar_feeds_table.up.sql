@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS calendar_feeds (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    version BIGINT DEFAULT 0,
+    created_at TIMESTAMP(0) WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP(0) WITH TIME ZONE DEFAULT NULL,
+    deleted_at TIMESTAMP(0) WITH TIME ZONE DEFAULT NULL,
+
+    CONSTRAINT fk_user_profile FOREIGN KEY (user_id) REFERENCES user_profiles (id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_calendar_feeds_user_id ON calendar_feeds (user_id) WHERE deleted_at IS NULL;
+
+CREATE TRIGGER update_calendar_feeds_updated_at BEFORE UPDATE
+ON calendar_feeds FOR EACH ROW EXECUTE PROCEDURE 
+update_updated_at_column();
\ No newline at end of file
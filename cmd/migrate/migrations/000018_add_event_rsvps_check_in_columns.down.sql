@@ -0,0 +1,5 @@
+ALTER TABLE event_rsvps
+    DROP CONSTRAINT IF EXISTS fk_checked_in_by,
+    DROP COLUMN IF EXISTS checked_in_by,
+    DROP COLUMN IF EXISTS checked_in_at,
+    DROP COLUMN IF EXISTS ticket;
\ No newline at end of file
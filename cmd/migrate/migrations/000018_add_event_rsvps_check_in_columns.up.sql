@@ -0,0 +1,5 @@
+ALTER TABLE event_rsvps
+    ADD COLUMN IF NOT EXISTS ticket TEXT DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS checked_in_at TIMESTAMP(0) WITH TIME ZONE DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS checked_in_by BIGINT DEFAULT NULL,
+    ADD CONSTRAINT fk_checked_in_by FOREIGN KEY (checked_in_by) REFERENCES user_profiles (id);
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_venues_updated_at ON venues;
+
+DROP TABLE IF EXISTS venues;
\ No newline at end of file
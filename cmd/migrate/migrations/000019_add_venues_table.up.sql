@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS venues (
+    id BIGSERIAL PRIMARY KEY,
+    org_id BIGINT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    address TEXT NOT NULL,
+    latitude DOUBLE PRECISION NOT NULL,
+    longitude DOUBLE PRECISION NOT NULL,
+    capacity INT NOT NULL,
+    accessibility_notes TEXT NOT NULL DEFAULT '',
+    version BIGINT DEFAULT 0,
+    created_at TIMESTAMP(0) WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP(0) WITH TIME ZONE DEFAULT NULL,
+    deleted_at TIMESTAMP(0) WITH TIME ZONE DEFAULT NULL,
+
+    CONSTRAINT fk_org FOREIGN KEY (org_id) REFERENCES organizations (id),
+    CONSTRAINT chk_venue_latitude CHECK (latitude BETWEEN -90 AND 90),
+    CONSTRAINT chk_venue_longitude CHECK (longitude BETWEEN -180 AND 180),
+    CONSTRAINT chk_venue_capacity CHECK (capacity > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_venues_org_id ON venues (org_id);
+CREATE INDEX IF NOT EXISTS idx_venues_latitude_longitude ON venues (latitude, longitude);
+
+CREATE TRIGGER update_venues_updated_at BEFORE UPDATE
+ON venues FOR EACH ROW EXECUTE PROCEDURE 
+update_updated_at_column();
\ No newline at end of file
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_events_venue_id;
+
+ALTER TABLE events
+    DROP CONSTRAINT IF EXISTS fk_venue,
+    DROP COLUMN IF EXISTS venue_id;
\ No newline at end of file
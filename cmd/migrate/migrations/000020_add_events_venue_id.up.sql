@@ -0,0 +1,5 @@
+ALTER TABLE events
+    ADD COLUMN IF NOT EXISTS venue_id BIGINT DEFAULT NULL,
+    ADD CONSTRAINT fk_venue FOREIGN KEY (venue_id) REFERENCES venues (id);
+
+CREATE INDEX IF NOT EXISTS idx_events_venue_id ON events (venue_id);
\ No newline at end of file
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_events_start_time_id;
+DROP INDEX IF EXISTS idx_events_topics;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS topics;
\ No newline at end of file
@@ -0,0 +1,6 @@
+ALTER TABLE events
+    ADD COLUMN IF NOT EXISTS topics TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_events_topics ON events USING GIN (topics);
+CREATE INDEX IF NOT EXISTS idx_events_start_time_id ON events (start_time, id)
+WHERE published_at IS NOT NULL AND deleted_at IS NULL;
\ No newline at end of file
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_user_profiles_search_vector;
+DROP INDEX IF EXISTS idx_events_search_vector;
+DROP INDEX IF EXISTS idx_organizations_search_vector;
+
+ALTER TABLE user_profiles DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE events DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE organizations DROP COLUMN IF EXISTS search_vector;
\ No newline at end of file
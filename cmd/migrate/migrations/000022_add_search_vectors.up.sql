@@ -0,0 +1,20 @@
+ALTER TABLE organizations
+    ADD COLUMN IF NOT EXISTS search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', name), 'A') ||
+        setweight(to_tsvector('english', description), 'B')
+    ) STORED;
+
+ALTER TABLE events
+    ADD COLUMN IF NOT EXISTS search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', title), 'A') ||
+        setweight(to_tsvector('english', description), 'B')
+    ) STORED;
+
+ALTER TABLE user_profiles
+    ADD COLUMN IF NOT EXISTS search_vector TSVECTOR GENERATED ALWAYS AS (
+        to_tsvector('simple', username)
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_organizations_search_vector ON organizations USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_events_search_vector ON events USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_user_profiles_search_vector ON user_profiles USING GIN (search_vector);
\ No newline at end of file
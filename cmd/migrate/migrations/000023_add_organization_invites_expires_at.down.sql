@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_organization_invites_org_id;
+
+ALTER TABLE organization_invites
+    DROP COLUMN IF EXISTS expires_at;
\ No newline at end of file
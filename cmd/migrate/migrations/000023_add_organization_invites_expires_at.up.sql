@@ -0,0 +1,10 @@
+ALTER TABLE organization_invites
+    ADD COLUMN IF NOT EXISTS expires_at TIMESTAMP(0) WITH TIME ZONE;
+
+UPDATE organization_invites SET expires_at = created_at + INTERVAL '7 days' WHERE expires_at IS NULL;
+
+ALTER TABLE organization_invites
+    ALTER COLUMN expires_at SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_organization_invites_org_id ON organization_invites (org_id)
+WHERE accepted_at IS NULL AND declined_at IS NULL AND deleted_at IS NULL;
\ No newline at end of file
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_organization_invites_email;
+
+DELETE FROM organization_invites WHERE user_id IS NULL;
+
+ALTER TABLE organization_invites
+    DROP CONSTRAINT IF EXISTS chk_invitee,
+    DROP COLUMN IF EXISTS email,
+    ALTER COLUMN user_id SET NOT NULL;
\ No newline at end of file
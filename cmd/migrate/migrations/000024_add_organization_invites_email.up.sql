@@ -0,0 +1,7 @@
+ALTER TABLE organization_invites
+    ALTER COLUMN user_id DROP NOT NULL,
+    ADD COLUMN IF NOT EXISTS email CITEXT DEFAULT NULL,
+    ADD CONSTRAINT chk_invitee CHECK (user_id IS NOT NULL OR email IS NOT NULL);
+
+CREATE INDEX IF NOT EXISTS idx_organization_invites_email ON organization_invites (email)
+WHERE user_id IS NULL AND deleted_at IS NULL;
\ No newline at end of file
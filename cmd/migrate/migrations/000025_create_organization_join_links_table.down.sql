@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_organization_join_links_updated_at ON organization_join_links;
+
+DROP TABLE IF EXISTS organization_join_links;
\ No newline at end of file
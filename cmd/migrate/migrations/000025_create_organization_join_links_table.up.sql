@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS organization_join_links (
+    id BIGSERIAL PRIMARY KEY,
+    org_id BIGINT NOT NULL,
+    role_id BIGINT NOT NULL,
+    token TEXT NOT NULL UNIQUE,
+    max_uses INT DEFAULT NULL,
+    uses INT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP(0) WITH TIME ZONE DEFAULT NULL,
+    disabled_at TIMESTAMP(0) WITH TIME ZONE DEFAULT NULL,
+    created_by BIGINT NOT NULL,
+    version BIGINT DEFAULT 0,
+    created_at TIMESTAMP(0) WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP(0) WITH TIME ZONE DEFAULT NULL,
+    deleted_at TIMESTAMP(0) WITH TIME ZONE DEFAULT NULL,
+
+    CONSTRAINT fk_org FOREIGN KEY (org_id) REFERENCES organizations (id),
+    CONSTRAINT fk_role FOREIGN KEY (role_id) REFERENCES roles (id),
+    CONSTRAINT fk_created_by FOREIGN KEY (created_by) REFERENCES user_profiles (id),
+    CONSTRAINT chk_join_link_max_uses CHECK (max_uses IS NULL OR max_uses > 0),
+    CONSTRAINT chk_join_link_uses CHECK (max_uses IS NULL OR uses <= max_uses)
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_join_links_org_id ON organization_join_links (org_id);
+
+CREATE TRIGGER update_organization_join_links_updated_at BEFORE UPDATE
+ON organization_join_links FOR EACH ROW EXECUTE PROCEDURE 
+update_updated_at_column();
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS organization_join_link_uses;
\ No newline at end of file
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS organization_join_link_uses (
+    id BIGSERIAL PRIMARY KEY,
+    link_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    member_id BIGINT NOT NULL,
+    created_at TIMESTAMP(0) WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_link FOREIGN KEY (link_id) REFERENCES organization_join_links (id),
+    CONSTRAINT fk_user_profile FOREIGN KEY (user_id) REFERENCES user_profiles (id),
+    CONSTRAINT fk_member FOREIGN KEY (member_id) REFERENCES organization_members (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_join_link_uses_link_id ON organization_join_link_uses (link_id);
\ No newline at end of file
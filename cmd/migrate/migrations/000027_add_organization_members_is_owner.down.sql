@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_organization_members_owners;
+
+ALTER TABLE organization_members
+    DROP COLUMN IF EXISTS is_owner;
\ No newline at end of file
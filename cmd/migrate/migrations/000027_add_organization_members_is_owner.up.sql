@@ -0,0 +1,12 @@
+ALTER TABLE organization_members
+    ADD COLUMN IF NOT EXISTS is_owner BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE organization_members SET is_owner = TRUE
+WHERE id IN (
+    SELECT DISTINCT ON (org_id) id FROM organization_members
+    WHERE deleted_at IS NULL
+    ORDER BY org_id, created_at ASC, id ASC
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_members_owners ON organization_members (org_id)
+WHERE is_owner AND deleted_at IS NULL;
\ No newline at end of file
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS organization_ownership_transfers (
+    id BIGSERIAL PRIMARY KEY,
+    org_id BIGINT NOT NULL,
+    from_member_id BIGINT NOT NULL,
+    to_member_id BIGINT NOT NULL,
+    created_at TIMESTAMP(0) WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_org FOREIGN KEY (org_id) REFERENCES organizations (id),
+    CONSTRAINT fk_from_member FOREIGN KEY (from_member_id) REFERENCES organization_members (id),
+    CONSTRAINT fk_to_member FOREIGN KEY (to_member_id) REFERENCES organization_members (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_ownership_transfers_org_id ON organization_ownership_transfers (org_id);
\ No newline at end of file
@@ -0,0 +1,8 @@
+ALTER TABLE events
+    ADD COLUMN IF NOT EXISTS series_end_time TIMESTAMP(0) WITH TIME ZONE DEFAULT NULL;
+
+-- Series that end on a date can not end after it. Series that end after a
+-- number of occurrences are treated as never ending until they are updated.
+UPDATE events
+SET series_end_time = TO_TIMESTAMP(SUBSTRING(rrule FROM 'UNTIL=([0-9]{8}T[0-9]{6})Z'), 'YYYYMMDD"T"HH24MISS')::TIMESTAMP AT TIME ZONE 'UTC'
+WHERE rrule LIKE '%UNTIL=%';
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_organization_members_org_id_user_id;
@@ -0,0 +1,10 @@
+-- Users who joined an organization twice keep their oldest membership.
+UPDATE organization_members SET deleted_at = NOW(), version = version + 1
+WHERE deleted_at IS NULL AND id NOT IN (
+    SELECT DISTINCT ON (org_id, user_id) id FROM organization_members
+    WHERE deleted_at IS NULL
+    ORDER BY org_id, user_id, is_owner DESC, created_at ASC, id ASC
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_organization_members_org_id_user_id ON organization_members (org_id, user_id)
+WHERE deleted_at IS NULL;
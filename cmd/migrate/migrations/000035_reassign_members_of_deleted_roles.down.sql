@@ -0,0 +1,2 @@
+-- Members can not be moved back to their deleted roles.
+SELECT 1;
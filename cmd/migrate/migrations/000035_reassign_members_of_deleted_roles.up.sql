@@ -0,0 +1,18 @@
+-- Members could be left holding a role that was deleted while they were
+-- being moved to it, which leaves them without any permissions. They are
+-- moved to a role without permissions that admins can reassign them from.
+INSERT INTO roles (name, description, org_id, permissions)
+SELECT DISTINCT 'No permissions', 'Members whose role was deleted', m.org_id, '{}'::VARCHAR(100)[]
+FROM organization_members m
+JOIN roles r ON r.id = m.role_id
+WHERE m.deleted_at IS NULL AND r.deleted_at IS NOT NULL;
+
+UPDATE organization_members m
+SET role_id = (
+    SELECT id FROM roles
+    WHERE org_id = m.org_id AND name = 'No permissions' AND deleted_at IS NULL
+    ORDER BY id DESC
+    LIMIT 1
+), version = m.version + 1
+FROM roles r
+WHERE r.id = m.role_id AND m.deleted_at IS NULL AND r.deleted_at IS NOT NULL;
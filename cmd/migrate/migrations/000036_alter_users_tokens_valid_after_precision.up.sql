@@ -0,0 +1,8 @@
+-- Token issue times are only precise to the second, so revocation times are
+-- rounded up to the next second.
+ALTER TABLE users
+    ALTER COLUMN tokens_valid_after TYPE TIMESTAMP(0) WITH TIME ZONE
+    USING CASE
+        WHEN tokens_valid_after = DATE_TRUNC('second', tokens_valid_after) THEN tokens_valid_after
+        ELSE DATE_TRUNC('second', tokens_valid_after) + INTERVAL '1 second'
+    END;
@@ -0,0 +1,3 @@
+ALTER TABLE user_two_factor
+    DROP COLUMN IF EXISTS attempts,
+    DROP COLUMN IF EXISTS last_attempt_at;
@@ -0,0 +1,3 @@
+ALTER TABLE user_two_factor
+    ADD COLUMN IF NOT EXISTS attempts INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS last_attempt_at TIMESTAMP(0) WITH TIME ZONE DEFAULT NULL;
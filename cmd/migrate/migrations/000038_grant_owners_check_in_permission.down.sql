@@ -0,0 +1,2 @@
+-- Owners may have granted the permission to other roles since, so it is kept.
+SELECT 1;
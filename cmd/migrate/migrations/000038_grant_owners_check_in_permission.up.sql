@@ -0,0 +1,11 @@
+-- Owner roles of organizations created before attendees could be checked in
+-- don't have the permission, and permissions can only be granted by members
+-- that hold them.
+UPDATE roles
+SET permissions = ARRAY_APPEND(COALESCE(permissions, '{}'), 'check_in_attendees'), version = version + 1
+WHERE deleted_at IS NULL
+AND NOT ('check_in_attendees' = ANY(COALESCE(permissions, '{}')))
+AND id IN (
+    SELECT role_id FROM organization_members
+    WHERE is_owner AND deleted_at IS NULL
+);
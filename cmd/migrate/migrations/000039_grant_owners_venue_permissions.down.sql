@@ -0,0 +1,3 @@
+-- Owners may have granted the permissions to other roles since, so they are
+-- kept.
+SELECT 1;
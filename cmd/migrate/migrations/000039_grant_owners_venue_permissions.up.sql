@@ -0,0 +1,15 @@
+-- Owner roles of organizations created before venues were added don't have
+-- the venue permissions, and permissions can only be granted by members that
+-- hold them.
+UPDATE roles
+SET permissions = COALESCE(permissions, '{}') || ARRAY(
+        SELECT permission FROM UNNEST(ARRAY['create_venue', 'update_venue', 'delete_venue']) AS permission
+        WHERE NOT (permission = ANY(COALESCE(roles.permissions, '{}')))
+    )::VARCHAR(100)[],
+    version = version + 1
+WHERE deleted_at IS NULL
+AND NOT (COALESCE(permissions, '{}') @> ARRAY['create_venue', 'update_venue', 'delete_venue']::VARCHAR(100)[])
+AND id IN (
+    SELECT role_id FROM organization_members
+    WHERE is_owner AND deleted_at IS NULL
+);
@@ -6,6 +6,7 @@ import (
 	"github.com/KengoWada/meetup-clone/internal/auth"
 	"github.com/KengoWada/meetup-clone/internal/config"
 	"github.com/KengoWada/meetup-clone/internal/db"
+	"github.com/KengoWada/meetup-clone/internal/mailer"
 	"github.com/KengoWada/meetup-clone/internal/store"
 	"github.com/KengoWada/meetup-clone/internal/store/cache"
 	"github.com/bradfitz/gomemcache/memcache"
@@ -16,6 +17,7 @@ type Application struct {
 	Store         store.Store
 	CacheStore    cache.Store
 	Authenticator auth.Authenticator
+	MailQueue     *mailer.Queue
 }
 
 type AppItems struct {
@@ -57,6 +59,13 @@ func NewApplication() (*AppItems, error) {
 	// Create JWT Authenticator
 	jwtAuthenticator := auth.NewJWTAuthenticator(cfg.AuthConfig.Secret, cfg.AuthConfig.Audience, cfg.AuthConfig.Issuer)
 
+	// Create mail queue
+	appMailer, err := mailer.New(cfg.MailConfig, cfg.Environment)
+	if err != nil {
+		return appItems, err
+	}
+	mailQueue := mailer.NewQueue(appMailer, cfg.MailConfig.Sender, cfg.MailConfig.Workers, cfg.MailConfig.MaxRetries)
+
 	// Create Global App Store
 	store := store.NewStore(db)
 	cacheStore := cache.NewCacheStore(memcached)
@@ -66,6 +75,7 @@ func NewApplication() (*AppItems, error) {
 		Store:         store,
 		CacheStore:    cacheStore,
 		Authenticator: jwtAuthenticator,
+		MailQueue:     mailQueue,
 	}
 	appItems.App = app
 
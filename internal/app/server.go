@@ -1,9 +1,13 @@
 package app
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/KengoWada/meetup-clone/docs"
@@ -11,9 +15,12 @@ import (
 	"github.com/KengoWada/meetup-clone/internal/logger"
 	appMiddleware "github.com/KengoWada/meetup-clone/internal/middleware"
 	"github.com/KengoWada/meetup-clone/internal/services/auth"
+	"github.com/KengoWada/meetup-clone/internal/services/calendars"
+	"github.com/KengoWada/meetup-clone/internal/services/events"
 	"github.com/KengoWada/meetup-clone/internal/services/organizations"
 	"github.com/KengoWada/meetup-clone/internal/services/profiles"
 	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/services/search"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	httpSwagger "github.com/swaggo/http-swagger/v2"
@@ -21,6 +28,10 @@ import (
 
 const version = "0.0.1"
 
+// shutdownTimeout is how long requests being handled are given to finish
+// once the server is shutting down.
+const shutdownTimeout = time.Second * 30
+
 var l = logger.Get()
 
 func (app *Application) Mount() http.Handler {
@@ -49,22 +60,37 @@ func (app *Application) Mount() http.Handler {
 			r.Get("/swagger/*", httpSwagger.Handler(httpSwagger.URL(docsURL)))
 		}
 
-		authHandler := auth.NewHandler(app.Store, app.CacheStore, app.Authenticator)
+		authHandler := auth.NewHandler(app.Store, app.CacheStore, app.Authenticator, app.MailQueue)
 		authMux := authHandler.RegisterRoutes()
 		r.Mount("/auth", authMux)
 
-		profileHandler := profiles.NewHandler(app.Store, app.Authenticator)
+		profileHandler := profiles.NewHandler(app.Store, app.CacheStore, app.Authenticator)
 		profileMux := profileHandler.RegisterRoutes()
 		r.Mount("/profiles", profileMux)
 
 		organizationHandler := organizations.NewHandler(app.Store, app.CacheStore)
 		organizationMux := organizationHandler.RegisterRoutes()
 		r.Mount("/organizations", organizationMux)
+
+		calendarHandler := calendars.NewHandler(app.Store)
+		calendarMux := calendarHandler.RegisterRoutes()
+		r.Mount("/calendars", calendarMux)
+
+		eventHandler := events.NewHandler(app.Store)
+		eventMux := eventHandler.RegisterRoutes()
+		r.Mount("/events", eventMux)
+
+		searchHandler := search.NewHandler(app.Store)
+		searchMux := searchHandler.RegisterRoutes()
+		r.Mount("/search", searchMux)
 	})
 
 	return mux
 }
 
+// Run serves the mux until the process is interrupted or terminated. It then
+// stops taking new requests, waits for the requests being handled and
+// delivers the queued emails before returning.
 func (app *Application) Run(mux http.Handler) error {
 	if app.Config.Environment == config.AppEnvDev {
 		docs.SwaggerInfo.Version = version
@@ -80,6 +106,30 @@ func (app *Application) Run(mux http.Handler) error {
 		IdleTimeout:  time.Minute,
 	}
 
+	shutdownErr := make(chan error, 1)
+	go func() {
+		quit := make(chan os.Signal, 1)
+		signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+		sig := <-quit
+
+		l.Info().Msgf("%s_env:server is shutting down: %s", app.Config.Environment, sig)
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		shutdownErr <- svr.Shutdown(ctx)
+	}()
+
 	l.Info().Msgf("%s_env:server is starting on port %s", app.Config.Environment, app.Config.Addr)
-	return svr.ListenAndServe()
+	if err := svr.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	err := <-shutdownErr
+
+	// Requests that were being handled may have queued emails, so the queue
+	// is only closed once they are done.
+	l.Info().Msgf("%s_env:delivering queued emails", app.Config.Environment)
+	app.MailQueue.Close()
+
+	return err
 }
@@ -0,0 +1,22 @@
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// GenerateTokenID creates a unique ID for an access token. It is used as
+// the token's jti claim so that the token can be revoked before it expires.
+func GenerateTokenID() (string, error) {
+	return randomHex(16)
+}
+
+// randomHex returns n random bytes encoded as hex.
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
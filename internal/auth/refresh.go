@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// refreshTokenLength is the number of random bytes in a refresh token.
+const refreshTokenLength = 32
+
+// GenerateRefreshToken creates a new opaque refresh token. It returns the
+// token to hand to the client along with the hash to store in its place.
+func GenerateRefreshToken() (token, hash string, err error) {
+	b := make([]byte, refreshTokenLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+
+	token = base64.RawURLEncoding.EncodeToString(b)
+	return token, HashRefreshToken(token), nil
+}
+
+// HashRefreshToken returns the hash a refresh token is stored as. Refresh
+// tokens are random, so a fast hash is enough to keep a leaked database
+// from handing out working tokens.
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// GenerateTokenFamily creates the ID of a new family of refresh tokens.
+// A family starts when a user logs in and is shared by every token it is
+// rotated into.
+func GenerateTokenFamily() (string, error) {
+	return randomHex(16)
+}
@@ -0,0 +1,202 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal/utils"
+)
+
+const (
+	totpPeriod       = 30 // seconds each code is valid for
+	totpDigits       = 6  // digits in each code
+	totpSkew         = 1  // time steps either side of now that are accepted, to allow for clock drift
+	totpSecretLength = 20 // random bytes in a secret, the size of a SHA-1 HMAC key
+
+	// RecoveryCodeCount is the number of recovery codes handed out when a
+	// user enables two-factor authentication.
+	RecoveryCodeCount = 10
+
+	recoveryCodeLength = 10 // characters in a recovery code, not counting the separator
+)
+
+// totpEncoding encodes secrets the way authenticator apps expect them.
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret creates a new base32 encoded secret for generating
+// time-based one-time passwords as described in RFC 6238.
+func GenerateTOTPSecret() (string, error) {
+	b := make([]byte, totpSecretLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return totpEncoding.EncodeToString(b), nil
+}
+
+// EncryptTOTPSecret encrypts the secret with the key so that it isn't
+// stored in plain text.
+func EncryptTOTPSecret(secret string, key []byte) (string, error) {
+	return utils.GeneratePurposeToken(utils.TokenPurposeTwoFactorSecret, secret, key)
+}
+
+// DecryptTOTPSecret returns the secret encrypted by EncryptTOTPSecret.
+// Secrets stored before they were encrypted are returned as they are.
+func DecryptTOTPSecret(encrypted string, key []byte) (string, error) {
+	timedToken, err := utils.ValidatePurposeToken(encrypted, utils.TokenPurposeTwoFactorSecret, key, utils.NoExpiry)
+	if err != nil {
+		if isTOTPSecret(encrypted) {
+			return encrypted, nil
+		}
+		return "", err
+	}
+
+	return timedToken.Body, nil
+}
+
+// isTOTPSecret reports whether the value is a secret created by
+// GenerateTOTPSecret.
+func isTOTPSecret(value string) bool {
+	key, err := totpEncoding.DecodeString(value)
+	return err == nil && len(key) == totpSecretLength
+}
+
+// TOTPURI returns the otpauth URI authenticator apps use to add the secret.
+// The issuer and account name are what the app shows the code under.
+func TOTPURI(secret, issuer, accountName string) string {
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprint(totpDigits))
+	params.Set("period", fmt.Sprint(totpPeriod))
+
+	// Authenticator apps don't all read "+" as a space.
+	query := strings.ReplaceAll(params.Encode(), "+", "%20")
+	label := url.PathEscape(issuer + ":" + accountName)
+	return fmt.Sprintf("otpauth://totp/%s?%s", label, query)
+}
+
+// ValidateTOTP checks the code against the secret at time t. Codes from the
+// time steps next to t are accepted as well to allow for clock drift. It
+// returns the time step the code belongs to so that callers can refuse to
+// accept a code twice.
+func ValidateTOTP(secret, code string, t time.Time) (int64, bool) {
+	if len(code) != totpDigits {
+		return 0, false
+	}
+
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return 0, false
+	}
+
+	current := t.Unix() / totpPeriod
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if subtle.ConstantTimeCompare([]byte(totpCode(key, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+// GenerateTOTP returns the code for the secret at time t.
+func GenerateTOTP(secret string, t time.Time) (string, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", err
+	}
+
+	return totpCode(key, t.Unix()/totpPeriod), nil
+}
+
+// IsTOTPCode reports whether the code looks like a TOTP code rather than a
+// recovery code.
+func IsTOTPCode(code string) bool {
+	if len(code) != totpDigits {
+		return false
+	}
+
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// totpCode generates the code for the time step using the HOTP algorithm
+// from RFC 4226.
+func totpCode(key []byte, step int64) string {
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for range totpDigits {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", totpDigits, value%mod)
+}
+
+// GenerateRecoveryCodes creates the one-time codes a user can log in with
+// when they don't have their authenticator app. It returns the codes to
+// show the user along with the hashes to store in their place.
+func GenerateRecoveryCodes() (codes, hashes []string, err error) {
+	codes = make([]string, RecoveryCodeCount)
+	hashes = make([]string, RecoveryCodeCount)
+
+	for i := range RecoveryCodeCount {
+		b := make([]byte, recoveryCodeLength)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+
+		code := strings.ToLower(totpEncoding.EncodeToString(b))[:recoveryCodeLength]
+		half := recoveryCodeLength / 2
+		codes[i] = code[:half] + "-" + code[half:]
+		hashes[i] = HashRecoveryCode(codes[i])
+	}
+
+	return codes, hashes, nil
+}
+
+// HashRecoveryCode returns the hash a recovery code is stored as. Codes are
+// compared without their separator and case, so users can type them in
+// however they like.
+func HashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// GenerateLoginChallenge creates the token a user finishes a two-factor log
+// in with. It returns the token to hand to the client along with the hash
+// to store in its place.
+func GenerateLoginChallenge() (token, hash string, err error) {
+	return GenerateRefreshToken()
+}
+
+// HashLoginChallenge returns the hash a login challenge token is stored as.
+func HashLoginChallenge(token string) string {
+	return HashRefreshToken(token)
+}
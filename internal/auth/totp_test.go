@@ -0,0 +1,151 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// rfc6238Key is the SHA-1 key from the test vectors in appendix B of
+// RFC 6238. The vectors there have 8 digits, so only their last 6 are used.
+var rfc6238Key = []byte("12345678901234567890")
+
+var rfc6238Vectors = []struct {
+	unix int64
+	code string
+}{
+	{unix: 59, code: "287082"},
+	{unix: 1111111109, code: "081804"},
+	{unix: 1111111111, code: "050471"},
+	{unix: 1234567890, code: "005924"},
+	{unix: 2000000000, code: "279037"},
+	{unix: 20000000000, code: "353130"},
+}
+
+func TestTOTPCode(t *testing.T) {
+	for _, tt := range rfc6238Vectors {
+		t.Run(tt.code, func(t *testing.T) {
+			assert.Equal(t, tt.code, totpCode(rfc6238Key, tt.unix/totpPeriod))
+		})
+	}
+}
+
+func TestGenerateTOTP(t *testing.T) {
+	secret := totpEncoding.EncodeToString(rfc6238Key)
+
+	for _, tt := range rfc6238Vectors {
+		t.Run(tt.code, func(t *testing.T) {
+			code, err := GenerateTOTP(secret, time.Unix(tt.unix, 0))
+			assert.Nil(t, err)
+			assert.Equal(t, tt.code, code)
+		})
+	}
+
+	_, err := GenerateTOTP("not base32!", time.Now())
+	assert.NotNil(t, err)
+}
+
+func TestValidateTOTP(t *testing.T) {
+	secret := totpEncoding.EncodeToString(rfc6238Key)
+	now := time.Unix(59, 0)
+
+	tests := []struct {
+		name   string
+		secret string
+		code   string
+		at     time.Time
+		step   int64
+		ok     bool
+	}{
+		{name: "current step", secret: secret, code: "287082", at: now, step: 1, ok: true},
+		{name: "lowercase secret", secret: strings.ToLower(secret), code: "287082", at: now, step: 1, ok: true},
+		{name: "previous step", secret: secret, code: "287082", at: now.Add(totpPeriod * time.Second), step: 1, ok: true},
+		{name: "next step", secret: secret, code: "287082", at: now.Add(-totpPeriod * time.Second), step: 1, ok: true},
+		{name: "outside skew", secret: secret, code: "287082", at: now.Add(2 * totpPeriod * time.Second)},
+		{name: "wrong code", secret: secret, code: "123456", at: now},
+		{name: "too short", secret: secret, code: "28708", at: now},
+		{name: "too long", secret: secret, code: "2870820", at: now},
+		{name: "invalid secret", secret: "not base32!", code: "287082", at: now},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := ValidateTOTP(tt.secret, tt.code, tt.at)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.step, step)
+		})
+	}
+}
+
+func TestGenerateTOTPSecret(t *testing.T) {
+	secret, err := GenerateTOTPSecret()
+	assert.Nil(t, err)
+	assert.True(t, isTOTPSecret(secret))
+
+	code, err := GenerateTOTP(secret, time.Now())
+	assert.Nil(t, err)
+
+	_, ok := ValidateTOTP(secret, code, time.Now())
+	assert.True(t, ok)
+}
+
+func TestTOTPSecretEncryption(t *testing.T) {
+	key := []byte("12345678901234567890123456789012")
+
+	secret, err := GenerateTOTPSecret()
+	assert.Nil(t, err)
+
+	encrypted, err := EncryptTOTPSecret(secret, key)
+	assert.Nil(t, err)
+	assert.NotContains(t, encrypted, secret)
+
+	decrypted, err := DecryptTOTPSecret(encrypted, key)
+	assert.Nil(t, err)
+	assert.Equal(t, secret, decrypted)
+
+	_, err = DecryptTOTPSecret(encrypted, []byte("21098765432109876543210987654321"))
+	assert.NotNil(t, err)
+
+	// Secrets stored before they were encrypted.
+	decrypted, err = DecryptTOTPSecret(secret, key)
+	assert.Nil(t, err)
+	assert.Equal(t, secret, decrypted)
+
+	_, err = DecryptTOTPSecret("not a secret", key)
+	assert.NotNil(t, err)
+}
+
+func TestIsTOTPCode(t *testing.T) {
+	assert.True(t, IsTOTPCode("012345"))
+	assert.False(t, IsTOTPCode("01234"))
+	assert.False(t, IsTOTPCode("01234a"))
+	assert.False(t, IsTOTPCode("abcde-fghij"))
+}
+
+func TestGenerateRecoveryCodes(t *testing.T) {
+	codes, hashes, err := GenerateRecoveryCodes()
+	assert.Nil(t, err)
+	assert.Len(t, codes, RecoveryCodeCount)
+	assert.Len(t, hashes, RecoveryCodeCount)
+
+	seen := map[string]bool{}
+	for i, code := range codes {
+		assert.Len(t, code, recoveryCodeLength+1)
+		assert.Equal(t, "-", code[recoveryCodeLength/2:recoveryCodeLength/2+1])
+		assert.False(t, IsTOTPCode(code))
+		assert.False(t, seen[code], "duplicate recovery code %s", code)
+		seen[code] = true
+
+		assert.Equal(t, HashRecoveryCode(code), hashes[i])
+	}
+}
+
+func TestHashRecoveryCode(t *testing.T) {
+	hash := HashRecoveryCode("abcde-fghij")
+	assert.Equal(t, hash, HashRecoveryCode("ABCDE-FGHIJ"))
+	assert.Equal(t, hash, HashRecoveryCode("abcdefghij"))
+	assert.Equal(t, hash, HashRecoveryCode("abcde fghij"))
+	assert.NotEqual(t, hash, HashRecoveryCode("abcde-fghik"))
+}
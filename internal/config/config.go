@@ -34,6 +34,32 @@ func Get() Config {
 			loglevel = int(zerolog.Disabled)
 		}
 
+		// Emails are only delivered by default in production.
+		mailDriver := MailDriverLog
+		if environment == AppEnvProd {
+			mailDriver = MailDriverSMTP
+		}
+
+		mailConfig := MailConfig{
+			Driver:     MailDriver(utils.EnvGetString("MAIL_DRIVER", string(mailDriver))),
+			Sender:     utils.EnvGetString("MAIL_SENDER", "MeetUp Clone <no-reply@meetup-clone.com>"),
+			Dir:        utils.EnvGetString("MAIL_DIR", "mail"),
+			Workers:    utils.EnvGetInt("MAIL_WORKERS", 2),
+			MaxRetries: utils.EnvGetInt("MAIL_MAX_RETRIES", 3),
+		}
+		if environment == AppEnvTest {
+			mailConfig.Driver = MailDriverLog
+		}
+
+		// The SMTP server settings are only needed when emails are delivered
+		// through it.
+		if mailConfig.Driver == MailDriverSMTP {
+			mailConfig.Host = utils.EnvGetString("SMTP_HOST", "")
+			mailConfig.Port = utils.EnvGetInt("SMTP_PORT", 587)
+			mailConfig.Username = utils.EnvGetString("SMTP_USERNAME", "")
+			mailConfig.Password = utils.EnvGetString("SMTP_PASSWORD", "")
+		}
+
 		appConfig = Config{
 			Addr:        utils.EnvGetString("SERVER_ADDR", ""),
 			Debug:       utils.EnvGetBool("DEBUG", false),
@@ -42,6 +68,7 @@ func Get() Config {
 			ApiURL:      utils.EnvGetString("API_URL", ""),
 			LogLevel:    loglevel,
 			SecretKey:   utils.EnvGetString("SECRET_KEY", ""),
+			InviteExp:   utils.EnvGetInt("ORG_INVITE_EXP", 168),
 			DBConfig: DBConfig{
 				Addr:         utils.EnvGetString(dbAddr, ""),
 				MaxOpenConns: utils.EnvGetInt("DB_MAX_OPEN_CONNS", 30),
@@ -49,15 +76,17 @@ func Get() Config {
 				MaxIdleTime:  utils.EnvGetString("DB_MAX_IDLE_TIME", "15m"),
 			},
 			AuthConfig: AuthConfig{
-				Secret:   utils.EnvGetString("JWT_SECRET_KEY", ""),
-				Issuer:   utils.EnvGetString("JWT_ISSUER", "meetup_clone"),
-				Audience: utils.EnvGetString("JWT_AUDIENCE", "meetup_clone"),
-				Exp:      utils.EnvGetInt("JWT_ACCESS_EXP", 3),
+				Secret:     utils.EnvGetString("JWT_SECRET_KEY", ""),
+				Issuer:     utils.EnvGetString("JWT_ISSUER", "meetup_clone"),
+				Audience:   utils.EnvGetString("JWT_AUDIENCE", "meetup_clone"),
+				Exp:        utils.EnvGetInt("JWT_ACCESS_EXP_MINUTES", 15),
+				RefreshExp: utils.EnvGetInt("JWT_REFRESH_EXP", 720),
 			},
 			CacheConfig: CacheConfig{
 				ConnURLs: utils.EnvGetStringSlice("MEMCACHED_CONNS", []string{"localhost:11211"}),
 				Enabled:  environment != AppEnvTest,
 			},
+			MailConfig: mailConfig,
 		}
 	})
 
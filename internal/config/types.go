@@ -7,6 +7,13 @@ const (
 	AppEnvProd AppEnv = "prod" // Production environment
 )
 
+// Valid values for MailDriver.
+const (
+	MailDriverSMTP MailDriver = "smtp" // Deliver emails through an SMTP server
+	MailDriverFile MailDriver = "file" // Write emails to files in a directory
+	MailDriverLog  MailDriver = "log"  // Write emails to the application log
+)
+
 // Valid environment values for AppEnv.
 var Environments = []AppEnv{AppEnvDev, AppEnvTest, AppEnvProd}
 
@@ -17,6 +24,13 @@ var Environments = []AppEnv{AppEnvDev, AppEnvTest, AppEnvProd}
 //   - "prod" → Production environment
 type AppEnv string
 
+// MailDriver represents how the application delivers emails.
+// It is one of the following values:
+//   - "smtp" → Deliver emails through an SMTP server
+//   - "file" → Write emails to files in a directory
+//   - "log"  → Write emails to the application log
+type MailDriver string
+
 // Config holds the application configuration settings.
 type Config struct {
 	Addr        string     // The application port in the format ":8000".
@@ -26,9 +40,11 @@ type Config struct {
 	ApiURL      string     // The API URL, should match the Addr. (e.g., "localhost:8000").
 	LogLevel    int        // The log level for the app.
 	SecretKey   string     // The secret key used for generating and signing tokens.
+	InviteExp   int        // The organization invite expiration time in hours.
 	DBConfig    DBConfig   // The application database configurations
 	AuthConfig  AuthConfig // The application authentication configurations.
 	CacheConfig CacheConfig
+	MailConfig  MailConfig // The application email delivery configurations.
 }
 
 // DBConfig holds the database connection configuration settings.
@@ -41,10 +57,24 @@ type DBConfig struct {
 
 // AuthConfig holds the configuration settings for authentication and JWT handling.
 type AuthConfig struct {
-	Secret   string // The secret key used to sign and verify JWT tokens.
-	Issuer   string // The issuer claim (iss) for the tokens.
-	Audience string // The audience claim (aud) for the tokens.
-	Exp      int    // The token expiration time in hours.
+	Secret     string // The secret key used to sign and verify JWT tokens.
+	Issuer     string // The issuer claim (iss) for the tokens.
+	Audience   string // The audience claim (aud) for the tokens.
+	Exp        int    // The access token expiration time in minutes.
+	RefreshExp int    // The refresh token expiration time in hours.
+}
+
+// MailConfig holds the configuration settings for sending emails.
+type MailConfig struct {
+	Driver     MailDriver // How emails are delivered (e.g., "smtp", "file", "log").
+	Sender     string     // The address emails are sent from.
+	Host       string     // The SMTP server host.
+	Port       int        // The SMTP server port.
+	Username   string     // The username used to authenticate with the SMTP server.
+	Password   string     // The password used to authenticate with the SMTP server.
+	Dir        string     // The directory emails are written to by the file driver.
+	Workers    int        // The number of emails that are delivered at the same time.
+	MaxRetries int        // The number of times delivering an email is retried before it is dropped.
 }
 
 type CacheConfig struct {
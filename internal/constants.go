@@ -5,15 +5,27 @@ import "time"
 type userKey string
 type orgKey string
 type roleKey string
+type eventKey string
+type venueKey string
+type inviteKey string
+type joinLinkKey string
+type memberKey string
+type tokenClaimsKey string
 
 const (
 	DateTimeFormat = time.RFC3339
 	// mm/dd/yyyy
 	DateFormat = "01/02/2006"
 
-	UserCtx userKey = "user"
-	OrgCtx  orgKey  = "organization"
-	RoleCtx roleKey = "role"
+	UserCtx        userKey        = "user"
+	OrgCtx         orgKey         = "organization"
+	RoleCtx        roleKey        = "role"
+	EventCtx       eventKey       = "event"
+	VenueCtx       venueKey       = "venue"
+	InviteCtx      inviteKey      = "invite"
+	JoinLinkCtx    joinLinkKey    = "joinLink"
+	MemberCtx      memberKey      = "member"
+	TokenClaimsCtx tokenClaimsKey = "tokenClaims"
 
 	// Event permissions
 	EventCreate  = "create_event"
@@ -21,6 +33,12 @@ const (
 	EventUpdate  = "update_event"
 	EventCancel  = "cancel_event"
 	EventDelete  = "delete_event"
+	EventCheckIn = "check_in_attendees"
+
+	// Venue permissions
+	VenueCreate = "create_venue"
+	VenueUpdate = "update_venue"
+	VenueDelete = "delete_venue"
 
 	// Member permissions
 	MemberAdd        = "add_member"
@@ -41,14 +59,16 @@ const (
 var (
 	Permissions = []string{
 		EventCreate, EventPublish, EventUpdate,
-		EventCancel, EventDelete,
+		EventCancel, EventDelete, EventCheckIn,
+		VenueCreate, VenueUpdate, VenueDelete,
 		MemberAdd, MemberRemove, MemberRoleUpdate,
 		RoleCreate, RoleUpdate, RoleDelete,
 		OrgUpdate, OrgDeactivate, OrgDelete,
 	}
 
 	PermissionsMap = map[string][]string{
-		"events":        {EventCreate, EventPublish, EventUpdate, EventCancel, EventDelete},
+		"events":        {EventCreate, EventPublish, EventUpdate, EventCancel, EventDelete, EventCheckIn},
+		"venues":        {VenueCreate, VenueUpdate, VenueDelete},
 		"members":       {MemberAdd, MemberRemove, MemberRoleUpdate},
 		"roles":         {RoleCreate, RoleUpdate, RoleDelete},
 		"organizations": {OrgUpdate, OrgDeactivate, OrgDelete},
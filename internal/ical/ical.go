@@ -0,0 +1,167 @@
+// Package ical writes RFC 5545 iCalendar documents so that events can be
+// subscribed to from calendar clients.
+//
+// Only the properties needed to publish events are supported. All times are
+// written in UTC.
+package ical
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Constants representing the statuses an event can have in a calendar.
+const (
+	StatusConfirmed Status = "CONFIRMED"
+	StatusCancelled Status = "CANCELLED"
+)
+
+// dateTimeFormat is the UTC date time format RFC 5545 uses for DTSTART,
+// DTEND, DTSTAMP, RECURRENCE-ID and EXDATE.
+const dateTimeFormat = "20060102T150405Z"
+
+// maxLineLength is the number of octets after which content lines are
+// folded onto the next line.
+const maxLineLength = 75
+
+const productID = "-//meetup-clone//events//EN"
+
+// Status defines the type for the status of an event.
+type Status string
+
+// Calendar is a named collection of events.
+type Calendar struct {
+	Name   string
+	Events []Event
+}
+
+// Event is a single VEVENT. UID identifies the event across feeds and
+// Sequence is increased whenever the event changes so that clients pick up
+// the new details. Events that describe a single occurrence of a recurring
+// event share the UID of the series and set RecurrenceID to the start time
+// the occurrence would have had.
+type Event struct {
+	UID          string
+	Sequence     int64
+	Stamp        time.Time
+	RecurrenceID *time.Time
+	Start        time.Time
+	End          time.Time
+	Summary      string
+	Description  string
+	Location     string
+	URL          string
+	Status       Status
+	RRule        string
+	ExDates      []time.Time
+}
+
+// Bytes returns the calendar as an iCalendar document.
+func (c Calendar) Bytes() []byte {
+	var buf bytes.Buffer
+	writeLine(&buf, "BEGIN", "VCALENDAR")
+	writeLine(&buf, "VERSION", "2.0")
+	writeLine(&buf, "PRODID", productID)
+	writeLine(&buf, "CALSCALE", "GREGORIAN")
+	writeLine(&buf, "METHOD", "PUBLISH")
+	if c.Name != "" {
+		writeLine(&buf, "X-WR-CALNAME", escapeText(c.Name))
+	}
+
+	for _, event := range c.Events {
+		event.write(&buf)
+	}
+
+	writeLine(&buf, "END", "VCALENDAR")
+	return buf.Bytes()
+}
+
+func (e Event) write(buf *bytes.Buffer) {
+	writeLine(buf, "BEGIN", "VEVENT")
+	writeLine(buf, "UID", e.UID)
+	writeLine(buf, "SEQUENCE", fmt.Sprint(e.Sequence))
+	writeLine(buf, "DTSTAMP", formatDateTime(e.Stamp))
+	if e.RecurrenceID != nil {
+		writeLine(buf, "RECURRENCE-ID", formatDateTime(*e.RecurrenceID))
+	}
+	writeLine(buf, "DTSTART", formatDateTime(e.Start))
+	writeLine(buf, "DTEND", formatDateTime(e.End))
+
+	if e.RRule != "" {
+		writeLine(buf, "RRULE", e.RRule)
+	}
+
+	if len(e.ExDates) > 0 {
+		exDates := make([]string, len(e.ExDates))
+		for index, exDate := range e.ExDates {
+			exDates[index] = formatDateTime(exDate)
+		}
+		writeLine(buf, "EXDATE", strings.Join(exDates, ","))
+	}
+
+	writeLine(buf, "SUMMARY", escapeText(e.Summary))
+	if e.Description != "" {
+		writeLine(buf, "DESCRIPTION", escapeText(e.Description))
+	}
+
+	if e.Location != "" {
+		writeLine(buf, "LOCATION", escapeText(e.Location))
+	}
+
+	if e.URL != "" {
+		writeLine(buf, "URL", e.URL)
+	}
+
+	if e.Status != "" {
+		writeLine(buf, "STATUS", string(e.Status))
+	}
+
+	writeLine(buf, "END", "VEVENT")
+}
+
+func formatDateTime(t time.Time) string {
+	return t.UTC().Format(dateTimeFormat)
+}
+
+// escapeText escapes the characters RFC 5545 does not allow unescaped in
+// TEXT values.
+func escapeText(value string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	)
+
+	return replacer.Replace(value)
+}
+
+// writeLine writes the content line, folding it onto continuation lines
+// that start with a space when it is longer than maxLineLength octets. Lines
+// are only folded between characters so multi-byte characters are kept
+// whole.
+func writeLine(buf *bytes.Buffer, name, value string) {
+	line := name + ":" + value
+	limit := maxLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		buf.WriteString(line[:cut])
+		buf.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines start with a space which counts towards
+		// the line length.
+		limit = maxLineLength - 1
+	}
+
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
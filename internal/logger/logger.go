@@ -96,3 +96,27 @@ func ErrLoggerCache(r *http.Request, err error) {
 		Err(errors.Wrap(err, "cache error")).
 		Msg("Cache Error")
 }
+
+func ErrLoggerMailer(r *http.Request, err error) {
+	logger := Get()
+
+	reqIDRaw := middleware.GetReqID(r.Context())
+	logger.Error().
+		Str("requestID", reqIDRaw).
+		Str("method", r.Method).
+		Str("url", r.URL.Path).
+		Err(errors.Wrap(err, "mailer error")).
+		Msg("Mailer Error")
+}
+
+func ErrLoggerSession(r *http.Request, err error) {
+	logger := Get()
+
+	reqIDRaw := middleware.GetReqID(r.Context())
+	logger.Error().
+		Str("requestID", reqIDRaw).
+		Str("method", r.Method).
+		Str("url", r.URL.Path).
+		Err(errors.Wrap(err, "session error")).
+		Msg("Session Error")
+}
@@ -0,0 +1,64 @@
+package mailer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// FileMailer writes emails to files in a directory instead of delivering
+// them. It is meant for development, where the emails can be opened with
+// any email client.
+type FileMailer struct {
+	dir string // directory the emails are written to
+}
+
+// NewFileMailer creates a new FileMailer that writes emails to dir.
+func NewFileMailer(dir string) *FileMailer {
+	return &FileMailer{dir}
+}
+
+// Send writes the message to a new .eml file in the mailer's directory.
+func (m *FileMailer) Send(msg Message) error {
+	if err := os.MkdirAll(m.dir, 0o755); err != nil {
+		return err
+	}
+
+	body, err := msg.bytes()
+	if err != nil {
+		return err
+	}
+
+	boundary, err := newBoundary()
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s_%s.eml", time.Now().UTC().Format("20060102T150405"), boundary[:8])
+	return os.WriteFile(filepath.Join(m.dir, name), body, 0o644)
+}
+
+// LogMailer writes emails to the application log instead of delivering
+// them. It is meant for development and tests. Only the recipient and the
+// subject are logged, since email bodies carry tokens.
+type LogMailer struct {
+	log zerolog.Logger // logger the emails are written to
+}
+
+// NewLogMailer creates a new LogMailer that writes emails to log.
+func NewLogMailer(log zerolog.Logger) *LogMailer {
+	return &LogMailer{log}
+}
+
+// Send writes the recipient and subject of the message to the log.
+func (m *LogMailer) Send(msg Message) error {
+	m.log.Info().
+		Str("to", msg.To).
+		Str("subject", msg.Subject).
+		Msg("Email sent")
+
+	return nil
+}
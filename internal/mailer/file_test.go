@@ -0,0 +1,61 @@
+package mailer
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestMessage() Message {
+	return Message{
+		From:      "MeetUp Clone <sender@meetup-clone.com>",
+		To:        "user@meetup-clone.com",
+		Subject:   "Activate your MeetUp Clone account",
+		PlainBody: "https://meetup-clone.com/activate?token=secret-token",
+		HTMLBody:  `<a href="https://meetup-clone.com/activate?token=secret-token">Activate</a>`,
+	}
+}
+
+func TestFileMailer(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "mail")
+	mailer := NewFileMailer(dir)
+
+	msg := newTestMessage()
+	for range 2 {
+		if err := mailer.Send(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, files, 2)
+
+	content, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(t, string(content), "To: user@meetup-clone.com\r\n")
+	assert.Contains(t, string(content), msg.PlainBody)
+	assert.Contains(t, string(content), msg.HTMLBody)
+}
+
+func TestLogMailer(t *testing.T) {
+	var buf bytes.Buffer
+	mailer := NewLogMailer(zerolog.New(&buf))
+
+	msg := newTestMessage()
+	if err := mailer.Send(msg); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Contains(t, buf.String(), msg.To)
+	assert.Contains(t, buf.String(), msg.Subject)
+	assert.NotContains(t, buf.String(), "secret-token")
+}
@@ -0,0 +1,104 @@
+// Package mailer sends the emails the application needs to reach its users,
+// such as account activation and password reset emails.
+//
+// Emails are built from templates in the templates directory. Each template
+// defines a "subject", a "plainBody" and an "htmlBody". How an email is
+// delivered is decided by the Mailer it is handed to, and a Queue delivers
+// emails in the background so that requests do not wait on the mail server.
+package mailer
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	htmlTemplate "html/template"
+	"text/template"
+
+	"github.com/KengoWada/meetup-clone/internal/config"
+	"github.com/KengoWada/meetup-clone/internal/logger"
+)
+
+// Template names for the emails the application sends.
+const (
+	UserActivationTemplate = "user_activation.tmpl"
+	PasswordResetTemplate  = "password_reset.tmpl"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+// ErrLogDriverInProd is returned by New when the log driver is configured in
+// production, where emails have to reach users.
+var ErrLogDriverInProd = errors.New("the log mail driver can not be used in production")
+
+// Message is an email ready to be delivered.
+type Message struct {
+	From      string
+	To        string
+	Subject   string
+	PlainBody string
+	HTMLBody  string
+}
+
+// Mailer defines the interface for delivering emails.
+type Mailer interface {
+	// Send delivers the message. It returns an error if the message could
+	// not be delivered.
+	Send(msg Message) error
+}
+
+// New creates the Mailer for the configured driver. The log driver is
+// refused in production.
+func New(cfg config.MailConfig, environment config.AppEnv) (Mailer, error) {
+	if cfg.Driver == config.MailDriverLog && environment == config.AppEnvProd {
+		return nil, ErrLogDriverInProd
+	}
+
+	switch cfg.Driver {
+	case config.MailDriverSMTP:
+		return NewSMTPMailer(cfg.Host, cfg.Port, cfg.Username, cfg.Password), nil
+	case config.MailDriverFile:
+		return NewFileMailer(cfg.Dir), nil
+	case config.MailDriverLog:
+		return NewLogMailer(logger.Get()), nil
+	default:
+		return nil, fmt.Errorf("unknown mail driver %q", cfg.Driver)
+	}
+}
+
+// NewMessage builds a message to the recipient from the named template,
+// filling the template in with data.
+func NewMessage(from, to, templateName string, data any) (Message, error) {
+	msg := Message{From: from, To: to}
+
+	textTmpl, err := template.New("").ParseFS(templateFS, "templates/"+templateName)
+	if err != nil {
+		return Message{}, err
+	}
+
+	subject := new(bytes.Buffer)
+	if err := textTmpl.ExecuteTemplate(subject, "subject", data); err != nil {
+		return Message{}, err
+	}
+	msg.Subject = subject.String()
+
+	plainBody := new(bytes.Buffer)
+	if err := textTmpl.ExecuteTemplate(plainBody, "plainBody", data); err != nil {
+		return Message{}, err
+	}
+	msg.PlainBody = plainBody.String()
+
+	htmlTmpl, err := htmlTemplate.New("").ParseFS(templateFS, "templates/"+templateName)
+	if err != nil {
+		return Message{}, err
+	}
+
+	htmlBody := new(bytes.Buffer)
+	if err := htmlTmpl.ExecuteTemplate(htmlBody, "htmlBody", data); err != nil {
+		return Message{}, err
+	}
+	msg.HTMLBody = htmlBody.String()
+
+	return msg, nil
+}
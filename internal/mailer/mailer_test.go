@@ -0,0 +1,97 @@
+package mailer
+
+import (
+	"testing"
+
+	"github.com/KengoWada/meetup-clone/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		data     any
+		subject  string
+		url      string
+	}{
+		{
+			name:     "user activation",
+			template: UserActivationTemplate,
+			data:     map[string]string{"ActivationURL": "https://meetup-clone.com/activate?token=abc"},
+			subject:  "Activate your MeetUp Clone account",
+			url:      "https://meetup-clone.com/activate?token=abc",
+		},
+		{
+			name:     "password reset",
+			template: PasswordResetTemplate,
+			data:     map[string]string{"ResetURL": "https://meetup-clone.com/reset?token=abc"},
+			subject:  "Reset your MeetUp Clone password",
+			url:      "https://meetup-clone.com/reset?token=abc",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := NewMessage("sender@meetup-clone.com", "user@meetup-clone.com", tt.template, tt.data)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, "sender@meetup-clone.com", msg.From)
+			assert.Equal(t, "user@meetup-clone.com", msg.To)
+			assert.Equal(t, tt.subject, msg.Subject)
+			assert.Contains(t, msg.PlainBody, tt.url)
+			assert.Contains(t, msg.HTMLBody, `href="https://meetup-clone.com/`)
+		})
+	}
+
+	t.Run("escapes html body", func(t *testing.T) {
+		data := map[string]string{"ActivationURL": `"><script>alert(1)</script>`}
+		msg, err := NewMessage("sender@meetup-clone.com", "user@meetup-clone.com", UserActivationTemplate, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.NotContains(t, msg.HTMLBody, "<script>")
+	})
+
+	t.Run("unknown template", func(t *testing.T) {
+		_, err := NewMessage("sender@meetup-clone.com", "user@meetup-clone.com", "unknown.tmpl", nil)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name        string
+		driver      config.MailDriver
+		environment config.AppEnv
+		err         error
+	}{
+		{name: "smtp in production", driver: config.MailDriverSMTP, environment: config.AppEnvProd},
+		{name: "file in development", driver: config.MailDriverFile, environment: config.AppEnvDev},
+		{name: "log in development", driver: config.MailDriverLog, environment: config.AppEnvDev},
+		{name: "log in production", driver: config.MailDriverLog, environment: config.AppEnvProd, err: ErrLogDriverInProd},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.MailConfig{Driver: tt.driver, Host: "localhost", Port: 587, Dir: t.TempDir()}
+			mailer, err := New(cfg, tt.environment)
+			if tt.err != nil {
+				assert.Equal(t, tt.err, err)
+				assert.Nil(t, mailer)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.NotNil(t, mailer)
+		})
+	}
+
+	t.Run("unknown driver", func(t *testing.T) {
+		_, err := New(config.MailConfig{Driver: "unknown"}, config.AppEnvDev)
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,137 @@
+package mailer
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal/logger"
+)
+
+// queueSize is the number of emails that can wait to be delivered before
+// new emails are turned away.
+const queueSize = 100
+
+// retryBackoff is how long a worker waits before retrying the first failed
+// delivery of an email. The wait doubles after every failed attempt.
+const retryBackoff = time.Second
+
+var (
+	ErrQueueFull   = errors.New("mail queue is full")
+	ErrQueueClosed = errors.New("mail queue is closed")
+)
+
+// Queue builds emails from templates and delivers them in the background.
+// Failed deliveries are retried with a growing wait between attempts.
+type Queue struct {
+	mailer     Mailer        // delivers the emails
+	sender     string        // address the emails are sent from
+	maxRetries int           // times a failed delivery is retried
+	backoff    time.Duration // wait before retrying the first failed delivery
+	messages   chan Message  // emails waiting to be delivered
+	mu         sync.RWMutex  // guards closed
+	closed     bool          // true once the queue stops taking emails
+	wg         sync.WaitGroup
+}
+
+// NewQueue creates a new Queue and starts workers that deliver its emails
+// with mailer.
+func NewQueue(mailer Mailer, sender string, workers, maxRetries int) *Queue {
+	return newQueue(mailer, sender, workers, maxRetries, retryBackoff)
+}
+
+// newQueue creates a new Queue like NewQueue, waiting backoff before the
+// first retry of a failed delivery.
+func newQueue(mailer Mailer, sender string, workers, maxRetries int, backoff time.Duration) *Queue {
+	q := &Queue{
+		mailer:     mailer,
+		sender:     sender,
+		maxRetries: maxRetries,
+		backoff:    backoff,
+		messages:   make(chan Message, queueSize),
+	}
+
+	for range max(workers, 1) {
+		q.wg.Add(1)
+		go q.work()
+	}
+
+	return q
+}
+
+// Send builds an email to the recipient from the named template and queues
+// it for delivery. It returns once the email is queued, so template errors
+// are reported but delivery errors are only logged.
+func (q *Queue) Send(to, templateName string, data any) error {
+	msg, err := NewMessage(q.sender, to, templateName, data)
+	if err != nil {
+		return err
+	}
+
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	if q.closed {
+		return ErrQueueClosed
+	}
+
+	select {
+	case q.messages <- msg:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// Close stops the queue from taking new emails and waits for the queued
+// emails to be delivered.
+func (q *Queue) Close() {
+	q.mu.Lock()
+	if !q.closed {
+		q.closed = true
+		close(q.messages)
+	}
+	q.mu.Unlock()
+
+	q.wg.Wait()
+}
+
+func (q *Queue) work() {
+	defer q.wg.Done()
+
+	for msg := range q.messages {
+		q.deliver(msg)
+	}
+}
+
+func (q *Queue) deliver(msg Message) {
+	log := logger.Get()
+	backoff := q.backoff
+
+	for attempt := 0; ; attempt++ {
+		err := q.mailer.Send(msg)
+		if err == nil {
+			return
+		}
+
+		if attempt >= q.maxRetries {
+			log.Error().
+				Err(err).
+				Str("to", msg.To).
+				Str("subject", msg.Subject).
+				Int("attempts", attempt+1).
+				Msg("Failed to send email")
+			return
+		}
+
+		log.Warn().
+			Err(err).
+			Str("to", msg.To).
+			Str("subject", msg.Subject).
+			Int("attempt", attempt+1).
+			Msg("Retrying email")
+
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
@@ -0,0 +1,95 @@
+package mailer
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testMailer records the messages it is asked to send and fails the first
+// failures attempts.
+type testMailer struct {
+	mu       sync.Mutex
+	failures int
+	attempts int
+	sent     []Message
+}
+
+func (m *testMailer) Send(msg Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.attempts++
+	if m.attempts <= m.failures {
+		return errors.New("mail server is unavailable")
+	}
+
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+func TestQueue(t *testing.T) {
+	data := map[string]string{"ActivationURL": "https://meetup-clone.com/activate?token=abc"}
+
+	t.Run("delivers queued emails before closing", func(t *testing.T) {
+		mailer := &testMailer{}
+		queue := newQueue(mailer, "sender@meetup-clone.com", 2, 0, time.Millisecond)
+
+		for range 5 {
+			if err := queue.Send("user@meetup-clone.com", UserActivationTemplate, data); err != nil {
+				t.Fatal(err)
+			}
+		}
+		queue.Close()
+
+		assert.Len(t, mailer.sent, 5)
+		assert.Equal(t, "sender@meetup-clone.com", mailer.sent[0].From)
+		assert.Equal(t, "user@meetup-clone.com", mailer.sent[0].To)
+	})
+
+	t.Run("retries failed deliveries", func(t *testing.T) {
+		mailer := &testMailer{failures: 2}
+		queue := newQueue(mailer, "sender@meetup-clone.com", 1, 2, time.Millisecond)
+
+		if err := queue.Send("user@meetup-clone.com", UserActivationTemplate, data); err != nil {
+			t.Fatal(err)
+		}
+		queue.Close()
+
+		assert.Equal(t, 3, mailer.attempts)
+		assert.Len(t, mailer.sent, 1)
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		mailer := &testMailer{failures: 5}
+		queue := newQueue(mailer, "sender@meetup-clone.com", 1, 2, time.Millisecond)
+
+		if err := queue.Send("user@meetup-clone.com", UserActivationTemplate, data); err != nil {
+			t.Fatal(err)
+		}
+		queue.Close()
+
+		assert.Equal(t, 3, mailer.attempts)
+		assert.Empty(t, mailer.sent)
+	})
+
+	t.Run("does not take emails once closed", func(t *testing.T) {
+		queue := newQueue(&testMailer{}, "sender@meetup-clone.com", 1, 0, time.Millisecond)
+		queue.Close()
+		queue.Close()
+
+		err := queue.Send("user@meetup-clone.com", UserActivationTemplate, data)
+		assert.Equal(t, ErrQueueClosed, err)
+	})
+
+	t.Run("reports template errors", func(t *testing.T) {
+		queue := newQueue(&testMailer{}, "sender@meetup-clone.com", 1, 0, time.Millisecond)
+		defer queue.Close()
+
+		err := queue.Send("user@meetup-clone.com", "unknown.tmpl", data)
+		assert.NotNil(t, err)
+	})
+}
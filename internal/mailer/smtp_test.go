@@ -0,0 +1,43 @@
+package mailer
+
+import (
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSMTPMailerTimeout(t *testing.T) {
+	// The server accepts connections but never greets the client.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		time.Sleep(time.Second)
+	}()
+
+	addr := listener.Addr().(*net.TCPAddr)
+	mailer := NewSMTPMailer("127.0.0.1", addr.Port, "", "")
+	mailer.timeout = time.Millisecond * 100
+	assert.Equal(t, net.JoinHostPort("127.0.0.1", strconv.Itoa(addr.Port)), mailer.addr)
+
+	start := time.Now()
+	err = mailer.Send(newTestMessage())
+	assert.NotNil(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+
+	netErr, ok := err.(net.Error)
+	assert.True(t, ok)
+	assert.True(t, netErr.Timeout())
+}
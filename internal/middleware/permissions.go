@@ -105,15 +105,7 @@ func HasOrgPermission(permissions []string, appStore store.Store, cacheStore cac
 			return
 		}
 
-		var hasPermission bool
-		for _, permission := range permissions {
-			if slices.Contains(role.Permissions, permission) {
-				hasPermission = true
-				break
-			}
-		}
-
-		if !hasPermission {
+		if !hasAnyPermission(role.Permissions, permissions) {
 			err := errors.New("user does not have permissions to perform action")
 			response.ErrorResponseForbidden(w, r, err)
 			return
@@ -125,6 +117,84 @@ func HasOrgPermission(permissions []string, appStore store.Store, cacheStore cac
 	return http.HandlerFunc(fn)
 }
 
+// MemberHasOrgPermission reports whether the user holds at least one of the
+// permissions in the organization. Users who are not members of the
+// organization, or whose role was deleted, hold no permissions.
+func MemberHasOrgPermission(ctx context.Context, r *http.Request, appStore store.Store, cacheStore cache.Store, permissions []string, userID, orgID int64) (bool, error) {
+	rolePermissions, err := memberOrgPermissions(ctx, r, appStore, cacheStore, userID, orgID)
+	if err != nil {
+		return false, err
+	}
+
+	return hasAnyPermission(rolePermissions, permissions), nil
+}
+
+// ValidateGrantablePermissions checks that the user holds every one of the
+// permissions in the organization, so that members can not hand out more
+// access than they have. It returns a validation error for each permission
+// the user does not hold, keyed by the permission.
+func ValidateGrantablePermissions(ctx context.Context, r *http.Request, appStore store.Store, cacheStore cache.Store, permissions []string, userID, orgID int64) (response.ErrorsResponse, error) {
+	rolePermissions, err := memberOrgPermissions(ctx, r, appStore, cacheStore, userID, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	errorMessages := response.ErrorsResponse{}
+	for _, permission := range permissions {
+		if !slices.Contains(rolePermissions, permission) {
+			errorMessages[permission] = "You can not grant a permission you do not have"
+		}
+	}
+
+	if len(errorMessages) == 0 {
+		return nil, nil
+	}
+
+	return errorMessages, nil
+}
+
+// GetOrgMembership returns the user's membership of the organization along
+// with their role, using the same cached lookups as HasOrgPermission. It
+// returns store.ErrNotFound if the user is not a member of the organization
+// or their role was deleted.
+func GetOrgMembership(ctx context.Context, r *http.Request, appStore store.Store, cacheStore cache.Store, userID, orgID int64) (*models.OrganizationMember, *models.Role, error) {
+	member, err := getOrganizationMember(ctx, r, appStore, cacheStore, userID, orgID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	role, err := getRole(ctx, r, appStore, cacheStore, member.RoleID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return member, role, nil
+}
+
+// memberOrgPermissions returns the permissions the user holds in the
+// organization.
+func memberOrgPermissions(ctx context.Context, r *http.Request, appStore store.Store, cacheStore cache.Store, userID, orgID int64) ([]string, error) {
+	_, role, err := GetOrgMembership(ctx, r, appStore, cacheStore, userID, orgID)
+	if err != nil {
+		if err == store.ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return role.Permissions, nil
+}
+
+func hasAnyPermission(rolePermissions, permissions []string) bool {
+	for _, permission := range permissions {
+		if slices.Contains(rolePermissions, permission) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func getOrganizationMember(ctx context.Context, r *http.Request, appStore store.Store, cacheStore cache.Store, userID, orgID int64) (*models.OrganizationMember, error) {
 	var fields = []string{"user_id", "org_id"}
 	var values = []any{userID, orgID}
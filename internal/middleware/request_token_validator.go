@@ -6,10 +6,12 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/KengoWada/meetup-clone/internal"
 	"github.com/KengoWada/meetup-clone/internal/auth"
 	"github.com/KengoWada/meetup-clone/internal/config"
+	"github.com/KengoWada/meetup-clone/internal/logger"
 	"github.com/KengoWada/meetup-clone/internal/models"
 	"github.com/KengoWada/meetup-clone/internal/services/response"
 	"github.com/KengoWada/meetup-clone/internal/store"
@@ -19,6 +21,10 @@ import (
 
 var cfg = config.Get()
 
+// sessionTouchInterval is how often a session's last seen time is updated
+// while it is being used.
+const sessionTouchInterval = 5 * time.Minute
+
 func JWTMiddleware(jwtAuthenticator auth.Authenticator, appStore store.Store, cacheStore cache.Store) func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		fn := func(w http.ResponseWriter, r *http.Request) {
@@ -45,6 +51,20 @@ func JWTMiddleware(jwtAuthenticator auth.Authenticator, appStore store.Store, ca
 
 			claims, _ := jwtToken.Claims.(jwt.MapClaims)
 
+			jti, _ := claims["jti"].(string)
+			if jti == "" {
+				err := fmt.Errorf("token has no jti claim: %s", authHeader)
+				response.ErrorResponseUnauthorized(w, r, err)
+				return
+			}
+
+			issuedAt, err := claims.GetIssuedAt()
+			if err != nil || issuedAt == nil {
+				err := fmt.Errorf("token has no valid iat claim: %s", authHeader)
+				response.ErrorResponseUnauthorized(w, r, err)
+				return
+			}
+
 			userID, err := strconv.ParseInt(fmt.Sprintf("%.f", claims["sub"]), 10, 64)
 			if err != nil {
 				response.ErrorResponseUnauthorized(w, r, err)
@@ -52,6 +72,18 @@ func JWTMiddleware(jwtAuthenticator auth.Authenticator, appStore store.Store, ca
 			}
 
 			ctx := r.Context()
+			isRevoked, err := isTokenRevoked(ctx, r, jti, appStore, cacheStore)
+			if err != nil {
+				response.ErrorResponseInternalServerErr(w, r, err)
+				return
+			}
+
+			if isRevoked {
+				err := fmt.Errorf("revoked token used: %s", jti)
+				response.ErrorResponseUnauthorized(w, r, err)
+				return
+			}
+
 			user, err := getUser(ctx, userID, appStore, cacheStore)
 			if err != nil {
 				switch err {
@@ -68,7 +100,45 @@ func JWTMiddleware(jwtAuthenticator auth.Authenticator, appStore store.Store, ca
 				return
 			}
 
+			if user.IsTokenRevoked(issuedAt.Time) {
+				err := fmt.Errorf("token issued before the user's tokens were revoked: %s", jti)
+				response.ErrorResponseUnauthorized(w, r, err)
+				return
+			}
+
+			if _, ok := claims["sid"]; !ok {
+				err := fmt.Errorf("token has no sid claim: %s", jti)
+				response.ErrorResponseUnauthorized(w, r, err)
+				return
+			}
+
+			sessionID, err := strconv.ParseInt(fmt.Sprintf("%.f", claims["sid"]), 10, 64)
+			if err != nil {
+				response.ErrorResponseUnauthorized(w, r, err)
+				return
+			}
+
+			session, err := getSession(ctx, r, sessionID, appStore, cacheStore)
+			if err != nil {
+				switch err {
+				case store.ErrNotFound:
+					response.ErrorResponseUnauthorized(w, r, err)
+				default:
+					response.ErrorResponseInternalServerErr(w, r, err)
+				}
+				return
+			}
+
+			if session.UserID != user.ID || session.IsRevoked() {
+				err := fmt.Errorf("token used for a revoked session: %d", sessionID)
+				response.ErrorResponseUnauthorized(w, r, err)
+				return
+			}
+
+			touchSession(ctx, r, session, appStore, cacheStore)
+
 			ctx = context.WithValue(ctx, internal.UserCtx, user)
+			ctx = context.WithValue(ctx, internal.TokenClaimsCtx, claims)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		}
 
@@ -103,3 +173,84 @@ func getUser(ctx context.Context, ID int64, appStore store.Store, cacheStore cac
 
 	return user, nil
 }
+
+// isTokenRevoked reports whether the token with the jti is on the
+// revocation list. Whether a token is revoked is cached for a short time so
+// that most requests don't hit the database.
+func isTokenRevoked(ctx context.Context, r *http.Request, jti string, appStore store.Store, cacheStore cache.Store) (bool, error) {
+	if !cfg.CacheConfig.Enabled {
+		return appStore.RevokedTokens.IsRevoked(ctx, jti)
+	}
+
+	isRevoked, err := cacheStore.RevokedTokens.Get(jti)
+	if err != nil {
+		logger.ErrLoggerCache(r, err)
+	}
+
+	if isRevoked != nil {
+		return *isRevoked, nil
+	}
+
+	revoked, err := appStore.RevokedTokens.IsRevoked(ctx, jti)
+	if err != nil {
+		return false, err
+	}
+
+	if err := cacheStore.RevokedTokens.Set(jti, revoked, cache.CacheTTLRevokedToken); err != nil {
+		logger.ErrLoggerCache(r, err)
+	}
+
+	return revoked, nil
+}
+
+// getSession fetches the session an access token was issued for, checking
+// the cache before the database.
+func getSession(ctx context.Context, r *http.Request, ID int64, appStore store.Store, cacheStore cache.Store) (*models.UserSession, error) {
+	if !cfg.CacheConfig.Enabled {
+		return appStore.Sessions.GetByID(ctx, ID)
+	}
+
+	session, err := cacheStore.Sessions.Get(ID)
+	if err != nil {
+		logger.ErrLoggerCache(r, err)
+	}
+
+	if session != nil {
+		return session, nil
+	}
+
+	session, err = appStore.Sessions.GetByID(ctx, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cacheStore.Sessions.Set(session); err != nil {
+		logger.ErrLoggerCache(r, err)
+	}
+
+	return session, nil
+}
+
+// touchSession records that the session was used. To keep writes down the
+// session is only updated when it was last seen more than
+// sessionTouchInterval ago. Failing to update it doesn't fail the request.
+//
+// The cached session is removed rather than replaced, as the session may be
+// revoked between the update and the cache write.
+func touchSession(ctx context.Context, r *http.Request, session *models.UserSession, appStore store.Store, cacheStore cache.Store) {
+	if !session.LastSeenBefore(time.Now().Add(-sessionTouchInterval)) {
+		return
+	}
+
+	err := appStore.Sessions.Touch(ctx, session)
+	if err != nil && err != store.ErrNotFound {
+		logger.ErrLoggerSession(r, err)
+		return
+	}
+
+	if cfg.CacheConfig.Enabled {
+		if err := cacheStore.Sessions.Delete(session.ID); err != nil {
+			logger.ErrLoggerCache(r, err)
+		}
+	}
+}
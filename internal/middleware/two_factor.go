@@ -0,0 +1,159 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/auth"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+)
+
+// TwoFactorCodeHeader is the header users with two-factor authentication
+// enabled send a code in to use routes guarded by HasTwoFactorCode.
+const TwoFactorCodeHeader = "X-2FA-Code"
+
+const (
+	twoFactorMaxAttempts = 5                // codes a user can try before they are locked out
+	twoFactorLockout     = 15 * time.Minute // how long a user is locked out for after their last attempt
+)
+
+// ErrTooManyTwoFactorAttempts is returned by VerifyTwoFactorCode when the
+// user tried too many codes and has to wait before trying again.
+var ErrTooManyTwoFactorAttempts = errors.New("too many two-factor authentication attempts")
+
+// TooManyTwoFactorAttemptsMessage is the error message sent to users who
+// tried too many codes.
+const TooManyTwoFactorAttemptsMessage = "Too many invalid two-factor authentication codes. Try again later"
+
+// HasTwoFactorCode guards routes that can't be undone. Users with two-factor
+// authentication enabled have to send a code from their authenticator app
+// or a recovery code in the X-2FA-Code header. Users without two-factor
+// authentication are let through.
+func HasTwoFactorCode(appStore store.Store, next http.HandlerFunc) http.HandlerFunc {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		user, _ := ctx.Value(internal.UserCtx).(*models.User)
+
+		twoFactor, err := appStore.TwoFactor.Get(ctx, user.ID)
+		if err != nil && err != store.ErrNotFound {
+			response.ErrorResponseInternalServerErr(w, r, err)
+			return
+		}
+
+		if err == store.ErrNotFound || !twoFactor.IsEnabled() {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		code := r.Header.Get(TwoFactorCodeHeader)
+		if code == "" {
+			err := errors.New("no two-factor code sent for a guarded route")
+			errorMessage := response.ErrorResponse{Message: "Two-factor authentication code required"}
+			response.ErrorResponseBadRequest(w, r, err, errorMessage)
+			return
+		}
+
+		ok, err := VerifyTwoFactorCode(ctx, appStore, twoFactor, code)
+		if err != nil {
+			switch err {
+			case ErrTooManyTwoFactorAttempts:
+				errorMessage := response.ErrorResponse{Message: TooManyTwoFactorAttemptsMessage}
+				response.ErrorResponseBadRequest(w, r, err, errorMessage)
+			default:
+				response.ErrorResponseInternalServerErr(w, r, err)
+			}
+			return
+		}
+
+		if !ok {
+			err := errors.New("invalid two-factor code sent for a guarded route")
+			errorMessage := response.ErrorResponse{Message: "Invalid two-factor authentication code"}
+			response.ErrorResponseBadRequest(w, r, err, errorMessage)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	}
+
+	return http.HandlerFunc(fn)
+}
+
+// VerifyTwoFactorCode checks the code against the user's TOTP secret or,
+// once two-factor authentication is enabled, their recovery codes. Codes
+// are used up when they are accepted: a TOTP code is not accepted twice and
+// a recovery code only works once.
+//
+// Users can try twoFactorMaxAttempts codes in a row. After that
+// ErrTooManyTwoFactorAttempts is returned until twoFactorLockout has passed
+// since their last attempt.
+func VerifyTwoFactorCode(ctx context.Context, appStore store.Store, twoFactor *models.TwoFactor, code string) (bool, error) {
+	// The attempt is counted before the code is checked so that concurrent
+	// requests can't get around the limit.
+	err := appStore.TwoFactor.RecordAttempt(ctx, twoFactor, twoFactorLockout)
+	if err != nil {
+		if err == store.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if twoFactor.Attempts > twoFactorMaxAttempts {
+		return false, ErrTooManyTwoFactorAttempts
+	}
+
+	ok, err := useTwoFactorCode(ctx, appStore, twoFactor, strings.TrimSpace(code))
+	if err != nil || !ok {
+		return false, err
+	}
+
+	if err := appStore.TwoFactor.ResetAttempts(ctx, twoFactor); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// useTwoFactorCode checks the code and uses it up if it is accepted.
+func useTwoFactorCode(ctx context.Context, appStore store.Store, twoFactor *models.TwoFactor, code string) (bool, error) {
+	if auth.IsTOTPCode(code) {
+		secret, err := auth.DecryptTOTPSecret(twoFactor.Secret, []byte(cfg.SecretKey))
+		if err != nil {
+			return false, err
+		}
+
+		step, ok := auth.ValidateTOTP(secret, code, time.Now())
+		if !ok {
+			return false, nil
+		}
+
+		err = appStore.TwoFactor.UseStep(ctx, twoFactor, step)
+		if err != nil {
+			if err == store.ErrNotFound {
+				return false, nil
+			}
+			return false, err
+		}
+
+		return true, nil
+	}
+
+	if !twoFactor.IsEnabled() {
+		return false, nil
+	}
+
+	err := appStore.TwoFactor.UseRecoveryCode(ctx, twoFactor.UserID, auth.HashRecoveryCode(code))
+	if err != nil {
+		if err == store.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,10 @@
+package models
+
+// CalendarFeed represents a user's personal iCalendar feed of the events
+// they RSVP'd to. The feed is read with a secret token in its URL instead
+// of the user's JWT, and revoking the feed invalidates every token issued
+// for it.
+type CalendarFeed struct {
+	BaseModel
+	UserProfileID int64 `json:"userProfileId"`
+}
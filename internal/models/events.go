@@ -0,0 +1,169 @@
+package models
+
+// Event represents a meetup that is hosted by an organization. It embeds
+// the BaseModel to include common fields such as ID, version, and timestamps.
+// An event happens either at a physical Location, an OnlineURL, or both.
+// Events start out as drafts and are only visible to non-members once
+// PublishedAt is set. Cancelled events remain readable but no longer
+// accept RSVPs. Recurring events have an RFC 5545 RRule and repeat from
+// StartTime, skipping the occurrences listed in ExDates. Topics are lower
+// case and help users discover events.
+type Event struct {
+	BaseModel
+	OrganizationID     int64         `json:"organizationId"`
+	Organization       *Organization `json:"organization,omitempty"`
+	Title              string        `json:"title"`
+	Description        string        `json:"description"`
+	StartTime          string        `json:"startTime"`
+	EndTime            string        `json:"endTime"`
+	Location           string        `json:"location"`
+	OnlineURL          string        `json:"onlineUrl"`
+	VenueID            *int64        `json:"venueId"`
+	Capacity           int           `json:"capacity"`
+	PublishedAt        *string       `json:"publishedAt"`
+	PublishedBy        *int64        `json:"publishedBy"`
+	CancelledAt        *string       `json:"cancelledAt"`
+	CancellationReason string        `json:"cancellationReason"`
+	RRule              string        `json:"rrule"`
+	ExDates            []string      `json:"exdates"`
+	Topics             []string      `json:"topics"`
+}
+
+// SimpleEvent is a trimmed down representation of an Event that is
+// returned to clients when listing or fetching events.
+type SimpleEvent struct {
+	ID                 int64    `json:"id"`
+	Title              string   `json:"title"`
+	Description        string   `json:"description"`
+	StartTime          string   `json:"startTime"`
+	EndTime            string   `json:"endTime"`
+	Location           string   `json:"location"`
+	OnlineURL          string   `json:"onlineUrl"`
+	VenueID            *int64   `json:"venueId"`
+	Capacity           int      `json:"capacity"`
+	PublishedAt        *string  `json:"publishedAt"`
+	CancelledAt        *string  `json:"cancelledAt"`
+	CancellationReason string   `json:"cancellationReason"`
+	RRule              string   `json:"rrule"`
+	ExDates            []string `json:"exdates"`
+	Topics             []string `json:"topics"`
+}
+
+// NearbyEvent is a published event held at a venue close to a location.
+// DistanceKm is the distance from the location to the venue and
+// NextStartTime is when the event, or its next occurrence, starts.
+type NearbyEvent struct {
+	SimpleEvent
+	OrganizationID int64       `json:"organizationId"`
+	Venue          SimpleVenue `json:"venue"`
+	DistanceKm     float64     `json:"distanceKm"`
+	NextStartTime  string      `json:"nextStartTime"`
+}
+
+// FeedEvent is a published event listed in the public discovery feed,
+// along with the organization hosting it.
+type FeedEvent struct {
+	SimpleEvent
+	Organization SimpleOrganization `json:"organization"`
+}
+
+// IsPublished checks if the event has been published. It returns true if
+// the event has a publish timestamp (PublishedAt is not nil).
+func (e Event) IsPublished() bool {
+	return e.PublishedAt != nil
+}
+
+// IsCancelled checks if the event has been cancelled. It returns true if
+// the event has a cancellation timestamp (CancelledAt is not nil).
+func (e Event) IsCancelled() bool {
+	return e.CancelledAt != nil
+}
+
+// IsRecurring checks if the event is a recurring series. It returns true
+// if the event has a recurrence rule (RRule is not empty).
+func (e Event) IsRecurring() bool {
+	return e.RRule != ""
+}
+
+// EventOccurrenceOverride holds the details of a single occurrence of a
+// recurring event that was edited on its own. OccurrenceStart is the start
+// time the occurrence would have had without the override.
+type EventOccurrenceOverride struct {
+	BaseModel
+	EventID         int64  `json:"eventId"`
+	OccurrenceStart string `json:"occurrenceStart"`
+	Title           string `json:"title"`
+	Description     string `json:"description"`
+	StartTime       string `json:"startTime"`
+	EndTime         string `json:"endTime"`
+	Location        string `json:"location"`
+	OnlineURL       string `json:"onlineUrl"`
+	Capacity        int    `json:"capacity"`
+}
+
+// EventOccurrence is a single occurrence of a recurring event as it is
+// returned to clients. OccurrenceStart identifies the occurrence and is
+// used when RSVPing to it.
+type EventOccurrence struct {
+	EventID         int64  `json:"eventId"`
+	OccurrenceStart string `json:"occurrenceStart"`
+	Title           string `json:"title"`
+	Description     string `json:"description"`
+	StartTime       string `json:"startTime"`
+	EndTime         string `json:"endTime"`
+	Location        string `json:"location"`
+	OnlineURL       string `json:"onlineUrl"`
+	Capacity        int    `json:"capacity"`
+	IsOverridden    bool   `json:"isOverridden"`
+}
+
+// Constants representing the different responses a user can give to an
+// event invitation.
+const (
+	RSVPGoing      RSVPStatus = "going"      // The user will attend the event.
+	RSVPNotGoing   RSVPStatus = "not_going"  // The user will not attend the event.
+	RSVPMaybe      RSVPStatus = "maybe"      // The user might attend the event.
+	RSVPWaitlisted RSVPStatus = "waitlisted" // The user wants to attend but the event is full.
+)
+
+// RSVPStatus defines the type for a user's response to an event.
+type RSVPStatus string
+
+// EventRSVP represents a user's response to an event. A user has at most
+// one RSVP per event which is updated whenever they change their response.
+// WaitlistPosition is only set while the RSVP is waitlisted, with the
+// first user in line at position 1. RSVPs to recurring events are made per
+// occurrence, identified by OccurrenceStart. Ticket is shown at the door
+// and CheckedInAt is set the first time it is scanned.
+type EventRSVP struct {
+	BaseModel
+	EventID          int64        `json:"eventId"`
+	Event            *Event       `json:"event,omitempty"`
+	UserProfileID    int64        `json:"userProfileId"`
+	UserProfile      *UserProfile `json:"userProfile,omitempty"`
+	OccurrenceStart  *string      `json:"occurrenceStart"`
+	Status           RSVPStatus   `json:"status"`
+	WaitlistPosition *int         `json:"waitlistPosition"`
+	Ticket           *string      `json:"ticket"`
+	CheckedInAt      *string      `json:"checkedInAt"`
+	CheckedInBy      *int64       `json:"checkedInBy"`
+}
+
+// HasCheckedIn checks if the user has been checked in to the event. It
+// returns true if the RSVP has a check in timestamp (CheckedInAt is not nil).
+func (r EventRSVP) HasCheckedIn() bool {
+	return r.CheckedInAt != nil
+}
+
+// EventAttendee is a trimmed down representation of an EventRSVP along
+// with the profile of the user who responded. It is returned to organizers
+// when listing an event's RSVPs.
+type EventAttendee struct {
+	UserProfileID    int64      `json:"userProfileId"`
+	Username         string     `json:"username"`
+	ProfilePic       string     `json:"profilePic"`
+	Status           RSVPStatus `json:"status"`
+	WaitlistPosition *int       `json:"waitlistPosition"`
+	RespondedAt      string     `json:"respondedAt"`
+	CheckedInAt      *string    `json:"checkedInAt"`
+}
@@ -0,0 +1,23 @@
+package models
+
+// Constants representing the different kinds of notifications that are
+// sent to users.
+const (
+	NotificationEventCancelled   NotificationType = "event_cancelled"   // An event the user RSVP'd to was cancelled.
+	NotificationWaitlistPromoted NotificationType = "waitlist_promoted" // The user was moved off an event's waitlist.
+)
+
+// NotificationType defines the type for the kind of a notification.
+type NotificationType string
+
+// Notification represents a message sent to a user about something that
+// happened in the application. EventID is set when the notification is
+// about a specific event.
+type Notification struct {
+	BaseModel
+	UserProfileID int64            `json:"userProfileId"`
+	EventID       *int64           `json:"eventId"`
+	Type          NotificationType `json:"type"`
+	Message       string           `json:"message"`
+	ReadAt        *string          `json:"readAt"`
+}
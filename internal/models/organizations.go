@@ -1,5 +1,11 @@
 package models
 
+import (
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+)
+
 type Organization struct {
 	BaseModel
 	Name        string `json:"name"`
@@ -31,6 +37,10 @@ type SimpleRole struct {
 	Permissions []string `json:"permissions"`
 }
 
+// OrganizationMember is a user who belongs to an organization with a role.
+// The member who creates an organization is its owner. An organization
+// always has an owner, who hands ownership over to another member before
+// they can leave.
 type OrganizationMember struct {
 	BaseModel
 	OrganizationID int64         `json:"organizationId"`
@@ -39,16 +49,132 @@ type OrganizationMember struct {
 	UserProfile    *UserProfile  `json:"userProfile"`
 	RoleID         int64         `json:"roleId"`
 	Role           *Role         `json:"role"`
+	IsOwner        bool          `json:"isOwner"`
+}
+
+// OwnershipTransfer is a record of the ownership of an organization being
+// handed from one member to another.
+type OwnershipTransfer struct {
+	ID             int64  `json:"id"`
+	OrganizationID int64  `json:"organizationId"`
+	FromMemberID   int64  `json:"fromMemberId"`
+	ToMemberID     int64  `json:"toMemberId"`
+	CreatedAt      string `json:"createdAt"`
+}
+
+// DirectoryMember is a trimmed down representation of an
+// OrganizationMember along with their profile and the name of their role.
+// It is returned to members when listing the members of an organization.
+type DirectoryMember struct {
+	UserProfileID int64  `json:"userProfileId"`
+	Username      string `json:"username"`
+	ProfilePic    string `json:"profilePic"`
+	RoleName      string `json:"roleName"`
+	IsOwner       bool   `json:"isOwner"`
+	JoinedAt      string `json:"joinedAt"`
+}
+
+// ManagedMember is a DirectoryMember along with the details members who
+// manage the organization's members need to act on them.
+type ManagedMember struct {
+	DirectoryMember
+	ID     int64  `json:"id"`
+	RoleID int64  `json:"roleId"`
+	Email  string `json:"email"`
 }
 
+// OrganizationInvite is an invite to join an organization with a role. It
+// is sent to a UserProfileID, or to an Email for people who do not have an
+// account yet. Email invites are moved to the profile once the account with
+// that email is activated.
 type OrganizationInvite struct {
 	BaseModel
 	OrganizationID int64         `json:"organizationId"`
 	Organization   *Organization `json:"organization"`
-	UserProfileID  int64         `json:"userProfileId"`
+	UserProfileID  *int64        `json:"userProfileId"`
 	UserProfile    *UserProfile  `json:"userProfile"`
+	Email          *string       `json:"email"`
 	RoleID         int64         `json:"roleId"`
 	Role           *Role         `json:"role"`
 	AcceptedAt     *string       `json:"acceptedAt"`
 	DeclinedAt     *string       `json:"declinedAt"`
+	ExpiresAt      string        `json:"expiresAt"`
+}
+
+// IsPending checks if the invite is still waiting on a response. It returns
+// true if the invite has been neither accepted nor declined.
+func (i OrganizationInvite) IsPending() bool {
+	return i.AcceptedAt == nil && i.DeclinedAt == nil
+}
+
+// IsExpired checks if the invite can no longer be accepted. It returns true
+// if the invite's expiry time (ExpiresAt) has passed.
+func (i OrganizationInvite) IsExpired() bool {
+	expiresAt, _ := time.Parse(internal.DateTimeFormat, i.ExpiresAt)
+	return !expiresAt.After(time.Now())
+}
+
+// PendingInvite is a trimmed down representation of an OrganizationInvite
+// that is returned to the invited user along with the organization they
+// were invited to and the role they will join with.
+type PendingInvite struct {
+	ID           int64              `json:"id"`
+	Organization SimpleOrganization `json:"organization"`
+	Role         SimpleRole         `json:"role"`
+	ExpiresAt    string             `json:"expiresAt"`
+	CreatedAt    string             `json:"createdAt"`
+}
+
+// SentInvite is a trimmed down representation of an OrganizationInvite that
+// is returned to organization admins along with the profile of the user it
+// was sent to and the role they were invited with. Invites sent to people
+// without an account only have an Email.
+type SentInvite struct {
+	ID            int64      `json:"id"`
+	UserProfileID *int64     `json:"userProfileId"`
+	Username      string     `json:"username"`
+	ProfilePic    string     `json:"profilePic"`
+	Email         string     `json:"email"`
+	Role          SimpleRole `json:"role"`
+	ExpiresAt     string     `json:"expiresAt"`
+	CreatedAt     string     `json:"createdAt"`
+}
+
+// OrganizationJoinLink is a link that can be shared to let anyone join an
+// organization with a role. A link can be used at most MaxUses times and
+// stops working at ExpiresAt, when they are set. Token is the part of the
+// link users send back to join.
+type OrganizationJoinLink struct {
+	BaseModel
+	OrganizationID int64   `json:"organizationId"`
+	RoleID         int64   `json:"roleId"`
+	Token          string  `json:"token"`
+	MaxUses        *int    `json:"maxUses"`
+	Uses           int     `json:"uses"`
+	ExpiresAt      *string `json:"expiresAt"`
+	DisabledAt     *string `json:"disabledAt"`
+	CreatedBy      int64   `json:"createdBy"`
+}
+
+// IsDisabled checks if the link has been disabled. It returns true if the
+// link has a disabled timestamp (DisabledAt is not nil).
+func (l OrganizationJoinLink) IsDisabled() bool {
+	return l.DisabledAt != nil
+}
+
+// IsExpired checks if the link can no longer be used. It returns true if the
+// link has an expiry time (ExpiresAt) that has passed.
+func (l OrganizationJoinLink) IsExpired() bool {
+	if l.ExpiresAt == nil {
+		return false
+	}
+
+	expiresAt, _ := time.Parse(internal.DateTimeFormat, *l.ExpiresAt)
+	return !expiresAt.After(time.Now())
+}
+
+// IsUsedUp checks if the link has been used as many times as it is allowed.
+// It returns false for links without a maximum number of uses.
+func (l OrganizationJoinLink) IsUsedUp() bool {
+	return l.MaxUses != nil && l.Uses >= *l.MaxUses
 }
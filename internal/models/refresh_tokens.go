@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+)
+
+// RefreshToken is an opaque token a user exchanges for a new access token.
+// Only a hash of the token is stored. Every time a refresh token is used it
+// is rotated for a new token in the same family, and replaying a rotated
+// token revokes the whole family.
+type RefreshToken struct {
+	ID        int64   `json:"id"`
+	UserID    int64   `json:"userId"`
+	FamilyID  string  `json:"familyId"`
+	TokenHash string  `json:"-"`
+	ExpiresAt string  `json:"expiresAt"`
+	RotatedAt *string `json:"rotatedAt"`
+	RevokedAt *string `json:"revokedAt"`
+	CreatedAt string  `json:"createdAt"`
+}
+
+// IsRotated checks if the token has already been exchanged for a new one.
+func (t RefreshToken) IsRotated() bool {
+	return t.RotatedAt != nil
+}
+
+// IsRevoked checks if the token's family has been revoked.
+func (t RefreshToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+// IsExpired checks if the token can no longer be used. It returns true if
+// the token's expiry time (ExpiresAt) has passed.
+func (t RefreshToken) IsExpired() bool {
+	expiresAt, _ := time.Parse(internal.DateTimeFormat, t.ExpiresAt)
+	return !expiresAt.After(time.Now())
+}
@@ -0,0 +1,11 @@
+package models
+
+// RevokedToken is an access token that was revoked before it expired, such
+// as when a user logs out. Tokens are identified by their jti claim and are
+// only kept until they would have expired.
+type RevokedToken struct {
+	JTI       string `json:"jti"`
+	UserID    int64  `json:"userId"`
+	ExpiresAt string `json:"expiresAt"`
+	CreatedAt string `json:"createdAt"`
+}
@@ -0,0 +1,27 @@
+package models
+
+// Constants representing the types of results that can be searched for.
+const (
+	SearchOrganizations SearchType = "organizations" // Search active organizations by name and description.
+	SearchEvents        SearchType = "events"        // Search published events by title and description.
+	SearchUsers         SearchType = "users"         // Search active users by username.
+)
+
+// SearchType defines the type for the kind of a search result.
+type SearchType string
+
+// SearchTypes are the types of results that can be searched for.
+var SearchTypes = []SearchType{SearchOrganizations, SearchEvents, SearchUsers}
+
+// SearchResult is an organization, event, or user that matched a search.
+// Title is the organization's name, event's title, or user's username.
+// Snippet is the part of the text that matched, HTML escaped, with the
+// matching words wrapped in <mark> tags. Results with a higher Rank are
+// a better match.
+type SearchResult struct {
+	Type    SearchType `json:"type"`
+	ID      int64      `json:"id"`
+	Title   string     `json:"title"`
+	Snippet string     `json:"snippet"`
+	Rank    float64    `json:"rank"`
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+)
+
+// UserSession is a device a user is logged in on. A session starts when
+// the user logs in and lasts as long as its family of refresh tokens.
+// Revoking a session logs the device out.
+type UserSession struct {
+	ID            int64   `json:"id"`
+	UserID        int64   `json:"userId"`
+	TokenFamilyID string  `json:"tokenFamilyId"`
+	Device        string  `json:"device"`
+	IPAddress     string  `json:"ipAddress"`
+	UserAgent     string  `json:"userAgent"`
+	LastSeenAt    string  `json:"lastSeenAt"`
+	RevokedAt     *string `json:"revokedAt"`
+	CreatedAt     string  `json:"createdAt"`
+}
+
+// IsRevoked checks if the session has been revoked.
+func (s UserSession) IsRevoked() bool {
+	return s.RevokedAt != nil
+}
+
+// LastSeenBefore checks if the session was last used before t.
+func (s UserSession) LastSeenBefore(t time.Time) bool {
+	lastSeenAt, _ := time.Parse(internal.DateTimeFormat, s.LastSeenAt)
+	return lastSeenAt.Before(t)
+}
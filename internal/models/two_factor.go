@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+)
+
+// TwoFactor holds a user's TOTP secret, encrypted with the application's
+// secret key. Two-factor authentication starts out pending and is only
+// enabled once the user confirms a code generated from the secret.
+type TwoFactor struct {
+	UserID        int64   `json:"userId"`
+	Secret        string  `json:"-"`
+	LastUsedStep  int64   `json:"-"` // time step of the last accepted code, so codes can't be replayed
+	Attempts      int     `json:"-"` // codes checked since the last accepted one, so codes can't be guessed
+	LastAttemptAt *string `json:"-"`
+	EnabledAt     *string `json:"enabledAt"`
+	CreatedAt     string  `json:"createdAt"`
+}
+
+// IsEnabled checks if the user has confirmed their two-factor enrollment.
+func (t TwoFactor) IsEnabled() bool {
+	return t.EnabledAt != nil
+}
+
+// LoginChallenge is handed out instead of tokens when a user with two-factor
+// authentication enabled logs in with their password. Only a hash of the
+// challenge token is stored. The user finishes logging in by sending the
+// challenge token along with a code.
+type LoginChallenge struct {
+	ID        int64   `json:"id"`
+	UserID    int64   `json:"userId"`
+	TokenHash string  `json:"-"`
+	Device    string  `json:"device"`
+	Attempts  int     `json:"attempts"`
+	ExpiresAt string  `json:"expiresAt"`
+	UsedAt    *string `json:"usedAt"`
+	CreatedAt string  `json:"createdAt"`
+}
+
+// IsUsed checks if the challenge has already been used to log in.
+func (c LoginChallenge) IsUsed() bool {
+	return c.UsedAt != nil
+}
+
+// IsExpired checks if the challenge can no longer be used. It returns true
+// if the challenge's expiry time (ExpiresAt) has passed.
+func (c LoginChallenge) IsExpired() bool {
+	expiresAt, _ := time.Parse(internal.DateTimeFormat, c.ExpiresAt)
+	return !expiresAt.After(time.Now())
+}
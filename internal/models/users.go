@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 // Constants representing the different user roles in the application.
 const (
 	UserAdminRole  UserRole = "admin"  // Role for admin users with full privileges.
@@ -22,6 +24,7 @@ type User struct {
 	ActivatedAt        *string      `json:"activatedAt"`           // Timestamp of when the user was activated (omitted from JSON).
 	Role               UserRole     `json:"role"`                  // The user's role (e.g., admin, staff, client).
 	PasswordResetToken string       `json:"passwordResetToken"`    // Token used for password reset (omitted from JSON).
+	TokensValidAfter   *string      `json:"tokensValidAfter"`      // Tokens issued before this time are revoked.
 	UserProfile        *UserProfile `json:"userProfile,omitempty"` // The user's profile.
 }
 
@@ -52,3 +55,22 @@ func (u User) IsDeactivated() bool {
 func (u User) IsActivated() bool {
 	return u.IsActive && u.ActivatedAt != nil
 }
+
+// IsTokenRevoked checks if a token issued at issuedAt has been revoked. It
+// returns true if the token was issued before the user's tokens were last
+// revoked (TokensValidAfter), which happens when the user resets their
+// password or is deactivated.
+func (u User) IsTokenRevoked(issuedAt time.Time) bool {
+	if u.TokensValidAfter == nil {
+		return false
+	}
+
+	validAfter, err := time.Parse(time.RFC3339Nano, *u.TokensValidAfter)
+	if err != nil {
+		return true
+	}
+
+	// TokensValidAfter is rounded up to the next second when tokens are
+	// revoked, as token issue times are only precise to the second.
+	return issuedAt.Before(validAfter)
+}
@@ -0,0 +1,28 @@
+package models
+
+// Venue represents a place that an organization hosts its events at. It
+// embeds the BaseModel to include common fields such as ID, version, and
+// timestamps. Latitude and Longitude are in decimal degrees and are used to
+// find events near a user.
+type Venue struct {
+	BaseModel
+	OrganizationID     int64   `json:"organizationId"`
+	Name               string  `json:"name"`
+	Address            string  `json:"address"`
+	Latitude           float64 `json:"latitude"`
+	Longitude          float64 `json:"longitude"`
+	Capacity           int     `json:"capacity"`
+	AccessibilityNotes string  `json:"accessibilityNotes"`
+}
+
+// SimpleVenue is a trimmed down representation of a Venue that is
+// returned to clients when listing venues or along with an event.
+type SimpleVenue struct {
+	ID                 int64   `json:"id"`
+	Name               string  `json:"name"`
+	Address            string  `json:"address"`
+	Latitude           float64 `json:"latitude"`
+	Longitude          float64 `json:"longitude"`
+	Capacity           int     `json:"capacity"`
+	AccessibilityNotes string  `json:"accessibilityNotes"`
+}
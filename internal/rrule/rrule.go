@@ -0,0 +1,415 @@
+// Package rrule implements the subset of RFC 5545 recurrence rules that is
+// used to describe recurring events. It supports the FREQ, INTERVAL, BYDAY,
+// COUNT and UNTIL rule parts.
+//
+// Occurrences are expanded in the location of the series start time, so a
+// series that starts at 18:00 UTC will always recur at 18:00 UTC.
+package rrule
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Constants representing the supported recurrence frequencies.
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+	Yearly  Frequency = "YEARLY"
+)
+
+// untilFormat is the UTC date time format RFC 5545 uses for UNTIL.
+const untilFormat = "20060102T150405Z"
+
+// maxPeriods caps how far a rule is expanded so that a rule which never
+// produces an occurrence can not loop forever.
+const maxPeriods = 100000
+
+var ErrInvalidRule = errors.New("invalid recurrence rule")
+
+// Frequency defines the type for how often a rule repeats.
+type Frequency string
+
+// Weekday is a day of the week used in BYDAY. N is the ordinal of the day
+// within the month e.g. 1 for the first Monday or -1 for the last Friday.
+// An N of 0 matches every such day.
+type Weekday struct {
+	Day time.Weekday
+	N   int
+}
+
+// Rule is a parsed recurrence rule.
+type Rule struct {
+	Freq     Frequency
+	Interval int
+	ByDay    []Weekday
+	Count    int
+	Until    *time.Time
+}
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// Parse parses a recurrence rule such as `FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH`.
+// An optional `RRULE:` prefix is ignored.
+func Parse(value string) (*Rule, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+	if value == "" {
+		return nil, ErrInvalidRule
+	}
+
+	rule := &Rule{Interval: 1}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(value, ";") {
+		name, val, ok := strings.Cut(part, "=")
+		if !ok || val == "" || seen[name] {
+			return nil, ErrInvalidRule
+		}
+		seen[name] = true
+
+		switch name {
+		case "FREQ":
+			rule.Freq = Frequency(val)
+			if !slices.Contains([]Frequency{Daily, Weekly, Monthly, Yearly}, rule.Freq) {
+				return nil, ErrInvalidRule
+			}
+		case "INTERVAL":
+			interval, err := strconv.Atoi(val)
+			if err != nil || interval < 1 {
+				return nil, ErrInvalidRule
+			}
+			rule.Interval = interval
+		case "COUNT":
+			count, err := strconv.Atoi(val)
+			if err != nil || count < 1 {
+				return nil, ErrInvalidRule
+			}
+			rule.Count = count
+		case "UNTIL":
+			until, err := parseUntil(val)
+			if err != nil {
+				return nil, ErrInvalidRule
+			}
+			rule.Until = &until
+		case "BYDAY":
+			for _, day := range strings.Split(val, ",") {
+				weekday, err := parseWeekday(day)
+				if err != nil {
+					return nil, err
+				}
+				rule.ByDay = append(rule.ByDay, weekday)
+			}
+		default:
+			return nil, ErrInvalidRule
+		}
+	}
+
+	if rule.Freq == "" || (rule.Count > 0 && rule.Until != nil) {
+		return nil, ErrInvalidRule
+	}
+
+	for _, weekday := range rule.ByDay {
+		// Ordinals only make sense within a month, and BYDAY on its own is
+		// ambiguous for yearly rules.
+		if (weekday.N != 0 && rule.Freq != Monthly) || rule.Freq == Yearly {
+			return nil, ErrInvalidRule
+		}
+	}
+
+	return rule, nil
+}
+
+func parseUntil(value string) (time.Time, error) {
+	if until, err := time.Parse(untilFormat, value); err == nil {
+		return until, nil
+	}
+
+	// A date only UNTIL includes every occurrence on that day.
+	until, err := time.Parse("20060102", value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return until.Add(24*time.Hour - time.Second), nil
+}
+
+func parseWeekday(value string) (Weekday, error) {
+	if len(value) < 2 {
+		return Weekday{}, ErrInvalidRule
+	}
+
+	day, ok := weekdays[value[len(value)-2:]]
+	if !ok {
+		return Weekday{}, ErrInvalidRule
+	}
+
+	weekday := Weekday{Day: day}
+	if ordinal := value[:len(value)-2]; ordinal != "" {
+		n, err := strconv.Atoi(ordinal)
+		if err != nil || n == 0 || n < -5 || n > 5 {
+			return Weekday{}, ErrInvalidRule
+		}
+		weekday.N = n
+	}
+
+	return weekday, nil
+}
+
+// String formats the rule the way it is written in RFC 5545.
+func (r Rule) String() string {
+	parts := []string{fmt.Sprintf("FREQ=%s", r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, fmt.Sprintf("INTERVAL=%d", r.Interval))
+	}
+
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for index, weekday := range r.ByDay {
+			days[index] = weekday.String()
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+
+	if r.Count > 0 {
+		parts = append(parts, fmt.Sprintf("COUNT=%d", r.Count))
+	}
+
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(untilFormat))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// String formats the weekday the way it is written in BYDAY e.g. `-1FR`.
+func (w Weekday) String() string {
+	for name, day := range weekdays {
+		if day == w.Day {
+			if w.N == 0 {
+				return name
+			}
+			return fmt.Sprintf("%d%s", w.N, name)
+		}
+	}
+
+	return ""
+}
+
+// Between returns the start times of the occurrences of a series starting
+// at dtstart that fall within from and to, both inclusive. As in RFC 5545,
+// dtstart is always the first occurrence of the series.
+func (r Rule) Between(dtstart, from, to time.Time) []time.Time {
+	var occurrences []time.Time
+	r.iterate(dtstart, func(occurrence time.Time) bool {
+		if occurrence.After(to) {
+			return false
+		}
+
+		if !occurrence.Before(from) {
+			occurrences = append(occurrences, occurrence)
+		}
+
+		return true
+	})
+
+	return occurrences
+}
+
+// IsOccurrence reports whether t is the start time of one of the
+// occurrences of a series starting at dtstart.
+func (r Rule) IsOccurrence(dtstart, t time.Time) bool {
+	return len(r.Between(dtstart, t, t)) == 1
+}
+
+// Next returns the start time of the first occurrence of a series starting
+// at dtstart that is after t and is not skipped. It returns false when the
+// series has no such occurrence.
+func (r Rule) Next(dtstart, t time.Time, skip func(time.Time) bool) (time.Time, bool) {
+	var next time.Time
+	var found bool
+	r.iterate(dtstart, func(occurrence time.Time) bool {
+		if !occurrence.After(t) || skip(occurrence) {
+			return true
+		}
+
+		next, found = occurrence, true
+		return false
+	})
+
+	return next, found
+}
+
+// Last returns the start time of the last occurrence of a series starting
+// at dtstart. It returns false when the series does not end, that is when
+// the rule has neither a COUNT nor an UNTIL.
+func (r Rule) Last(dtstart time.Time) (time.Time, bool) {
+	if r.Count == 0 && r.Until == nil {
+		return time.Time{}, false
+	}
+
+	last := dtstart
+	r.iterate(dtstart, func(occurrence time.Time) bool {
+		last = occurrence
+		return true
+	})
+
+	return last, true
+}
+
+// iterate calls fn with every occurrence of the series in order until fn
+// returns false or the series ends.
+func (r Rule) iterate(dtstart time.Time, fn func(time.Time) bool) {
+	count := 0
+	emit := func(occurrence time.Time) bool {
+		if r.Until != nil && occurrence.After(*r.Until) {
+			return false
+		}
+
+		count++
+		if !fn(occurrence) {
+			return false
+		}
+
+		return r.Count == 0 || count < r.Count
+	}
+
+	if !emit(dtstart) {
+		return
+	}
+
+	for period := 0; period < maxPeriods; period++ {
+		candidates := r.candidates(dtstart, period)
+		for _, candidate := range candidates {
+			if !candidate.After(dtstart) {
+				continue
+			}
+
+			if !emit(candidate) {
+				return
+			}
+		}
+
+		// Stop once whole periods start after UNTIL.
+		if r.Until != nil && r.periodStart(dtstart, period).After(*r.Until) {
+			return
+		}
+	}
+}
+
+// periodStart returns the first day of the given period of the series.
+func (r Rule) periodStart(dtstart time.Time, period int) time.Time {
+	step := period * r.Interval
+	switch r.Freq {
+	case Daily:
+		return dtstart.AddDate(0, 0, step)
+	case Weekly:
+		return startOfWeek(dtstart).AddDate(0, 0, 7*step)
+	case Monthly:
+		return startOfMonth(dtstart).AddDate(0, step, 0)
+	default:
+		return time.Date(dtstart.Year()+step, time.January, 1, 0, 0, 0, 0, dtstart.Location())
+	}
+}
+
+// candidates returns the occurrences of the series within the given
+// period, in order.
+func (r Rule) candidates(dtstart time.Time, period int) []time.Time {
+	start := r.periodStart(dtstart, period)
+	atClock := func(day time.Time) time.Time {
+		return time.Date(
+			day.Year(), day.Month(), day.Day(),
+			dtstart.Hour(), dtstart.Minute(), dtstart.Second(), 0,
+			dtstart.Location(),
+		)
+	}
+
+	var candidates []time.Time
+	switch r.Freq {
+	case Daily:
+		if len(r.ByDay) == 0 || r.matchesDay(start.Weekday()) {
+			candidates = append(candidates, start)
+		}
+	case Weekly:
+		for offset := range 7 {
+			day := start.AddDate(0, 0, offset)
+			if (len(r.ByDay) == 0 && day.Weekday() == dtstart.Weekday()) || r.matchesDay(day.Weekday()) {
+				candidates = append(candidates, atClock(day))
+			}
+		}
+	case Monthly:
+		if len(r.ByDay) == 0 {
+			day := start.AddDate(0, 0, dtstart.Day()-1)
+			if day.Month() == start.Month() {
+				candidates = append(candidates, atClock(day))
+			}
+			break
+		}
+
+		for day := start; day.Month() == start.Month(); day = day.AddDate(0, 0, 1) {
+			if r.matchesMonthDay(day) {
+				candidates = append(candidates, atClock(day))
+			}
+		}
+	case Yearly:
+		day := time.Date(start.Year(), dtstart.Month(), dtstart.Day(), 0, 0, 0, 0, dtstart.Location())
+		if day.Month() == dtstart.Month() {
+			candidates = append(candidates, atClock(day))
+		}
+	}
+
+	return candidates
+}
+
+func (r Rule) matchesDay(day time.Weekday) bool {
+	for _, weekday := range r.ByDay {
+		if weekday.Day == day {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesMonthDay reports whether the day matches BYDAY within its month,
+// taking ordinals such as `2TU` or `-1FR` into account.
+func (r Rule) matchesMonthDay(day time.Time) bool {
+	fromStart := (day.Day()-1)/7 + 1
+	daysInMonth := startOfMonth(day).AddDate(0, 1, -1).Day()
+	fromEnd := -((daysInMonth-day.Day())/7 + 1)
+
+	for _, weekday := range r.ByDay {
+		if weekday.Day != day.Weekday() {
+			continue
+		}
+
+		if weekday.N == 0 || weekday.N == fromStart || weekday.N == fromEnd {
+			return true
+		}
+	}
+
+	return false
+}
+
+// startOfWeek returns the Monday of the week the time falls in, since
+// weeks start on Monday unless WKST says otherwise.
+func startOfWeek(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7
+	day := t.AddDate(0, 0, -offset)
+	return time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, t.Location())
+}
+
+func startOfMonth(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
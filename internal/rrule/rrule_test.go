@@ -0,0 +1,280 @@
+package rrule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+		err   bool
+	}{
+		{name: "weekly with days", value: "FREQ=WEEKLY;BYDAY=TU,TH;COUNT=10", want: "FREQ=WEEKLY;BYDAY=TU,TH;COUNT=10"},
+		{name: "rrule prefix", value: "RRULE:FREQ=DAILY;INTERVAL=2", want: "FREQ=DAILY;INTERVAL=2"},
+		{name: "monthly with ordinal", value: "FREQ=MONTHLY;BYDAY=-1FR", want: "FREQ=MONTHLY;BYDAY=-1FR"},
+		{name: "date only until", value: "FREQ=DAILY;UNTIL=20250305", want: "FREQ=DAILY;UNTIL=20250305T235959Z"},
+		{name: "empty", value: "", err: true},
+		{name: "missing freq", value: "COUNT=3", err: true},
+		{name: "unknown freq", value: "FREQ=HOURLY", err: true},
+		{name: "count and until", value: "FREQ=DAILY;COUNT=3;UNTIL=20250305T000000Z", err: true},
+		{name: "repeated part", value: "FREQ=DAILY;FREQ=WEEKLY", err: true},
+		{name: "unknown part", value: "FREQ=DAILY;BYMONTH=3", err: true},
+		{name: "zero interval", value: "FREQ=DAILY;INTERVAL=0", err: true},
+		{name: "invalid day", value: "FREQ=WEEKLY;BYDAY=XX", err: true},
+		{name: "ordinal outside monthly", value: "FREQ=WEEKLY;BYDAY=1MO", err: true},
+		{name: "byday with yearly", value: "FREQ=YEARLY;BYDAY=MO", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := Parse(tt.value)
+			if tt.err {
+				assert.ErrorIs(t, err, ErrInvalidRule)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, rule.String())
+		})
+	}
+}
+
+func TestBetween(t *testing.T) {
+	// Tuesday 4th March 2025.
+	dtstart := time.Date(2025, time.March, 4, 18, 0, 0, 0, time.UTC)
+	day := func(month time.Month, d int) time.Time {
+		return time.Date(2025, month, d, 18, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name string
+		rule string
+		from time.Time
+		to   time.Time
+		want []time.Time
+	}{
+		{
+			name: "daily with count",
+			rule: "FREQ=DAILY;COUNT=3",
+			to:   day(time.December, 31),
+			want: []time.Time{day(time.March, 4), day(time.March, 5), day(time.March, 6)},
+		},
+		{
+			name: "daily with interval and until",
+			rule: "FREQ=DAILY;INTERVAL=2;UNTIL=20250310T180000Z",
+			to:   day(time.December, 31),
+			want: []time.Time{day(time.March, 4), day(time.March, 6), day(time.March, 8), day(time.March, 10)},
+		},
+		{
+			name: "daily with days",
+			rule: "FREQ=DAILY;BYDAY=MO,FR;COUNT=4",
+			to:   day(time.December, 31),
+			want: []time.Time{day(time.March, 4), day(time.March, 7), day(time.March, 10), day(time.March, 14)},
+		},
+		{
+			name: "weekly on the start day",
+			rule: "FREQ=WEEKLY;COUNT=3",
+			to:   day(time.December, 31),
+			want: []time.Time{day(time.March, 4), day(time.March, 11), day(time.March, 18)},
+		},
+		{
+			name: "weekly with days",
+			rule: "FREQ=WEEKLY;BYDAY=TU,TH;COUNT=4",
+			to:   day(time.December, 31),
+			want: []time.Time{day(time.March, 4), day(time.March, 6), day(time.March, 11), day(time.March, 13)},
+		},
+		{
+			name: "fortnightly until a date",
+			rule: "FREQ=WEEKLY;INTERVAL=2;UNTIL=20250401",
+			to:   day(time.December, 31),
+			want: []time.Time{day(time.March, 4), day(time.March, 18), day(time.April, 1)},
+		},
+		{
+			name: "monthly on the start day",
+			rule: "FREQ=MONTHLY;COUNT=3",
+			to:   day(time.December, 31),
+			want: []time.Time{day(time.March, 4), day(time.April, 4), day(time.May, 4)},
+		},
+		{
+			name: "monthly on the last friday",
+			rule: "FREQ=MONTHLY;BYDAY=-1FR;COUNT=3",
+			to:   day(time.December, 31),
+			want: []time.Time{day(time.March, 4), day(time.March, 28), day(time.April, 25)},
+		},
+		{
+			name: "monthly on the first monday",
+			rule: "FREQ=MONTHLY;BYDAY=1MO;COUNT=3",
+			to:   day(time.December, 31),
+			want: []time.Time{day(time.March, 4), day(time.April, 7), day(time.May, 5)},
+		},
+		{
+			name: "yearly",
+			rule: "FREQ=YEARLY;COUNT=2",
+			to:   time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC),
+			want: []time.Time{day(time.March, 4), time.Date(2026, time.March, 4, 18, 0, 0, 0, time.UTC)},
+		},
+		{
+			name: "window in the middle of the series",
+			rule: "FREQ=WEEKLY",
+			from: day(time.March, 10),
+			to:   day(time.March, 25),
+			want: []time.Time{day(time.March, 11), day(time.March, 18), day(time.March, 25)},
+		},
+		{
+			name: "count is counted from the series start",
+			rule: "FREQ=DAILY;COUNT=5",
+			from: day(time.March, 7),
+			to:   day(time.December, 31),
+			want: []time.Time{day(time.March, 7), day(time.March, 8)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := Parse(tt.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			from := tt.from
+			if from.IsZero() {
+				from = dtstart
+			}
+
+			assert.Equal(t, tt.want, rule.Between(dtstart, from, tt.to))
+		})
+	}
+}
+
+func TestIsOccurrence(t *testing.T) {
+	dtstart := time.Date(2025, time.March, 4, 18, 0, 0, 0, time.UTC)
+	rule, err := Parse("FREQ=WEEKLY;COUNT=3")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.True(t, rule.IsOccurrence(dtstart, dtstart))
+	assert.True(t, rule.IsOccurrence(dtstart, dtstart.AddDate(0, 0, 14)))
+	assert.False(t, rule.IsOccurrence(dtstart, dtstart.AddDate(0, 0, 21)))
+	assert.False(t, rule.IsOccurrence(dtstart, dtstart.AddDate(0, 0, 7).Add(time.Hour)))
+}
+
+func TestNext(t *testing.T) {
+	// Tuesday 4th March 2025.
+	dtstart := time.Date(2025, time.March, 4, 18, 0, 0, 0, time.UTC)
+	day := func(month time.Month, d int) time.Time {
+		return time.Date(2025, month, d, 18, 0, 0, 0, time.UTC)
+	}
+	noSkip := func(time.Time) bool { return false }
+
+	tests := []struct {
+		name  string
+		rule  string
+		after time.Time
+		skip  func(time.Time) bool
+		want  time.Time
+		found bool
+	}{
+		{
+			name:  "before the series starts",
+			rule:  "FREQ=WEEKLY",
+			after: day(time.March, 1),
+			skip:  noSkip,
+			want:  day(time.March, 4),
+			found: true,
+		},
+		{
+			name:  "on an occurrence",
+			rule:  "FREQ=WEEKLY",
+			after: day(time.March, 11),
+			skip:  noSkip,
+			want:  day(time.March, 18),
+			found: true,
+		},
+		{
+			name:  "between occurrences",
+			rule:  "FREQ=WEEKLY;BYDAY=TU,TH",
+			after: day(time.March, 5),
+			skip:  noSkip,
+			want:  day(time.March, 6),
+			found: true,
+		},
+		{
+			name:  "skipped occurrence",
+			rule:  "FREQ=WEEKLY",
+			after: day(time.March, 5),
+			skip:  func(occurrence time.Time) bool { return occurrence.Equal(day(time.March, 11)) },
+			want:  day(time.March, 18),
+			found: true,
+		},
+		{
+			name:  "after the last occurrence",
+			rule:  "FREQ=WEEKLY;COUNT=3",
+			after: day(time.March, 18),
+			skip:  noSkip,
+		},
+		{
+			name:  "after until",
+			rule:  "FREQ=DAILY;UNTIL=20250310T180000Z",
+			after: day(time.March, 11),
+			skip:  noSkip,
+		},
+		{
+			name:  "last occurrence skipped",
+			rule:  "FREQ=WEEKLY;COUNT=2",
+			after: day(time.March, 5),
+			skip:  func(occurrence time.Time) bool { return occurrence.Equal(day(time.March, 11)) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := Parse(tt.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			next, found := rule.Next(dtstart, tt.after, tt.skip)
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.want, next)
+		})
+	}
+}
+
+func TestLast(t *testing.T) {
+	// Tuesday 4th March 2025.
+	dtstart := time.Date(2025, time.March, 4, 18, 0, 0, 0, time.UTC)
+	day := func(month time.Month, d int) time.Time {
+		return time.Date(2025, month, d, 18, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name  string
+		rule  string
+		want  time.Time
+		found bool
+	}{
+		{name: "count", rule: "FREQ=WEEKLY;COUNT=3", want: day(time.March, 18), found: true},
+		{name: "until", rule: "FREQ=WEEKLY;BYDAY=TU,TH;UNTIL=20250314", want: day(time.March, 13), found: true},
+		{name: "until before start", rule: "FREQ=DAILY;UNTIL=20250301", want: dtstart, found: true},
+		{name: "never ends", rule: "FREQ=MONTHLY;BYDAY=-1FR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := Parse(tt.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			last, found := rule.Last(dtstart)
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.want, last)
+		})
+	}
+}
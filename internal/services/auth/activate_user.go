@@ -1,7 +1,6 @@
 package auth
 
 import (
-	"fmt"
 	"net/http"
 	"time"
 
@@ -139,8 +138,7 @@ func (h *Handler) resendVerificationEmail(w http.ResponseWriter, r *http.Request
 		response.ErrorResponseInternalServerErr(w, r, err)
 		return
 	}
-	// TODO: Send email to activate account.
-	fmt.Printf("'%s'\n", token)
+	h.sendActivationEmail(r, user.Email, token)
 
 	response.SuccessResponseOK(w, responseMessage, nil)
 }
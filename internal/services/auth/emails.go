@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/KengoWada/meetup-clone/internal/logger"
+	"github.com/KengoWada/meetup-clone/internal/mailer"
+)
+
+// sendActivationEmail queues an email with a link to activate the user's
+// account. Failing to queue the email does not fail the request because the
+// user can ask for the email to be sent again.
+func (h *Handler) sendActivationEmail(r *http.Request, email, token string) {
+	data := map[string]string{
+		"ActivationURL": fmt.Sprintf("%s/activate?token=%s", cfg.FrontendURL, url.QueryEscape(token)),
+	}
+
+	if err := h.mailQueue.Send(email, mailer.UserActivationTemplate, data); err != nil {
+		logger.ErrLoggerMailer(r, err)
+	}
+}
+
+// sendPasswordResetEmail queues an email with a link to reset the user's
+// password.
+func (h *Handler) sendPasswordResetEmail(r *http.Request, email, token string) {
+	data := map[string]string{
+		"ResetURL": fmt.Sprintf("%s/reset-password?token=%s", cfg.FrontendURL, url.QueryEscape(token)),
+	}
+
+	if err := h.mailQueue.Send(email, mailer.PasswordResetTemplate, data); err != nil {
+		logger.ErrLoggerMailer(r, err)
+	}
+}
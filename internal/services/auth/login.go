@@ -3,13 +3,11 @@ package auth
 import (
 	"errors"
 	"net/http"
-	"time"
 
 	"github.com/KengoWada/meetup-clone/internal/services/response"
 	"github.com/KengoWada/meetup-clone/internal/store"
 	"github.com/KengoWada/meetup-clone/internal/utils"
 	"github.com/KengoWada/meetup-clone/internal/validate"
-	"github.com/golang-jwt/jwt/v5"
 )
 
 var (
@@ -21,17 +19,19 @@ var (
 type loginUserPayload struct {
 	Email    string `json:"email" validate:"required"`
 	Password string `json:"password" validate:"required"`
+	Device   string `json:"device" validate:"omitempty,max=100"`
 }
 
 // LoginUser godoc
 //
 //	@Summary		Log in a user
-//	@Description	Log in a user
+//	@Description	Log in a user. Starts a session for the device and returns a short-lived access token and a refresh token to get new access tokens with. Users with two-factor authentication enabled get a challenge token instead, which they finish logging in with at /auth/login/2fa
 //	@Tags			auth
 //	@Accept			json
 //	@Produce		json
-//	@Param			payload	body		loginUserPayload						true	"log in payload"
-//	@Success		200		{object}	response.DocsSuccessResponseLoginUser	"user successfully logged in"
+//	@Param			payload	body		loginUserPayload							true	"log in payload"
+//	@Success		200		{object}	response.DocsSuccessResponseLoginUser		"user successfully logged in"
+//	@Success		202		{object}	response.DocsSuccessResponseLoginChallenge	"two-factor authentication required"
 //	@Failure		400		{object}	response.DocsErrorResponse
 //	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
 //	@Security
@@ -91,22 +91,23 @@ func (h *Handler) loginUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	exp := time.Hour * time.Duration(cfg.AuthConfig.Exp)
-	claims := jwt.MapClaims{
-		"sub": user.ID,
-		"exp": time.Now().Add(exp).Unix(),
-		"iat": time.Now().Unix(),
-		"nbf": time.Now().Unix(),
-		"iss": cfg.AuthConfig.Issuer,
-		"aud": cfg.AuthConfig.Audience,
+	twoFactor, err := h.store.TwoFactor.Get(r.Context(), user.ID)
+	if err != nil && err != store.ErrNotFound {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	if err == nil && twoFactor.IsEnabled() {
+		h.startLoginChallenge(w, r, user.ID, payload.Device)
+		return
 	}
 
-	token, err := h.authenticator.GenerateToken(claims)
+	token, refreshToken, err := h.startSession(r, user.ID, payload.Device)
 	if err != nil {
 		response.ErrorResponseInternalServerErr(w, r, err)
 		return
 	}
 
-	data := response.Response{"token": token}
+	data := response.Response{"token": token, "refreshToken": refreshToken}
 	response.SuccessResponseOK(w, "", data)
 }
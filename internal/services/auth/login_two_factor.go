@@ -0,0 +1,196 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/auth"
+	"github.com/KengoWada/meetup-clone/internal/middleware"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/utils"
+	"github.com/KengoWada/meetup-clone/internal/validate"
+)
+
+// loginChallengeTTL is how long a user has to finish logging in with their
+// second factor after logging in with their password.
+const loginChallengeTTL = 5 * time.Minute
+
+// loginChallengeMaxAttempts is the number of codes that can be tried
+// against a login challenge before the user has to log in again.
+const loginChallengeMaxAttempts = 5
+
+var (
+	errInvalidLoginChallenge = errors.New("invalid login challenge")
+	errInvalidTwoFactorCode  = errors.New("invalid two-factor code")
+)
+
+type loginTwoFactorPayload struct {
+	ChallengeToken string `json:"challengeToken" validate:"required"`
+	Code           string `json:"code" validate:"required"`
+}
+
+// startLoginChallenge hands out a challenge token instead of logging the
+// user in, since they have two-factor authentication enabled.
+func (h *Handler) startLoginChallenge(w http.ResponseWriter, r *http.Request, userID int64, device string) {
+	token, hash, err := auth.GenerateLoginChallenge()
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	challenge := &models.LoginChallenge{
+		UserID:    userID,
+		TokenHash: hash,
+		Device:    device,
+		ExpiresAt: time.Now().UTC().Add(loginChallengeTTL).Format(internal.DateTimeFormat),
+	}
+	if err := h.store.LoginChallenges.Create(r.Context(), challenge); err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	data := response.Response{"challengeToken": token}
+	response.SuccessResponseAccepted(w, "Two-factor authentication required", data)
+}
+
+// LoginTwoFactor godoc
+//
+//	@Summary		Finish logging in with two-factor authentication
+//	@Description	Exchange the challenge token from /auth/login and a code from the user's authenticator app or a recovery code for an access token and a refresh token. A challenge token expires after 5 minutes or 5 attempts. After 5 invalid codes in a row the user has to wait 15 minutes before trying again
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			payload	body		loginTwoFactorPayload					true	"two-factor log in payload"
+//	@Success		200		{object}	response.DocsSuccessResponseLoginUser	"user successfully logged in"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security
+//	@Router	/auth/login/2fa [post]
+func (h *Handler) loginTwoFactor(w http.ResponseWriter, r *http.Request) {
+	var payload loginTwoFactorPayload
+	if err := utils.ReadJSON(w, r, &payload); err != nil {
+		response.ErrorResponseInvalidJSON(w, r, err)
+		return
+	}
+
+	if errResponse, err := validate.ValidatePayload(payload, validate.FieldErrorMessages{}); err != nil {
+		switch err {
+		case validate.ErrFailedValidation:
+			errorMessage := response.NewValidationErrorResponse(errResponse)
+			response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	ctx := r.Context()
+
+	challenge, err := h.store.LoginChallenges.GetByTokenHash(ctx, auth.HashLoginChallenge(payload.ChallengeToken))
+	if err != nil {
+		switch err {
+		case store.ErrNotFound:
+			response.ErrorResponseUnauthorized(w, r, errInvalidLoginChallenge)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	if challenge.IsUsed() || challenge.IsExpired() || challenge.Attempts >= loginChallengeMaxAttempts {
+		response.ErrorResponseUnauthorized(w, r, errInvalidLoginChallenge)
+		return
+	}
+
+	// The attempt is counted before the code is checked so that concurrent
+	// requests can't get around the limit.
+	if err := h.store.LoginChallenges.RecordAttempt(ctx, challenge); err != nil {
+		switch err {
+		case store.ErrNotFound:
+			response.ErrorResponseUnauthorized(w, r, errInvalidLoginChallenge)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	if challenge.Attempts > loginChallengeMaxAttempts {
+		response.ErrorResponseUnauthorized(w, r, errInvalidLoginChallenge)
+		return
+	}
+
+	fields, values := []string{"id"}, []any{challenge.UserID}
+	user, err := h.store.Users.Get(ctx, false, fields, values)
+	if err != nil {
+		switch err {
+		case store.ErrNotFound:
+			response.ErrorResponseUnauthorized(w, r, errInvalidLoginChallenge)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	if user.IsDeactivated() || !user.IsActive {
+		response.ErrorResponseUnauthorized(w, r, errDeactivatedAccountLogin)
+		return
+	}
+
+	twoFactor, err := h.store.TwoFactor.Get(ctx, user.ID)
+	if err != nil {
+		switch err {
+		case store.ErrNotFound:
+			response.ErrorResponseUnauthorized(w, r, errInvalidLoginChallenge)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	if !twoFactor.IsEnabled() {
+		response.ErrorResponseUnauthorized(w, r, errInvalidLoginChallenge)
+		return
+	}
+
+	ok, err := middleware.VerifyTwoFactorCode(ctx, h.store, twoFactor, payload.Code)
+	if err != nil {
+		switch err {
+		case middleware.ErrTooManyTwoFactorAttempts:
+			errorMessage := response.ErrorResponse{Message: middleware.TooManyTwoFactorAttemptsMessage}
+			response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	if !ok {
+		errorMessage := response.ErrorResponse{Message: "Invalid two-factor authentication code"}
+		response.ErrorResponseBadRequest(w, r, errInvalidTwoFactorCode, errorMessage)
+		return
+	}
+
+	if err := h.store.LoginChallenges.Use(ctx, challenge); err != nil {
+		switch err {
+		case store.ErrNotFound:
+			response.ErrorResponseUnauthorized(w, r, errInvalidLoginChallenge)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	token, refreshToken, err := h.startSession(r, user.ID, challenge.Device)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	data := response.Response{"token": token, "refreshToken": refreshToken}
+	response.SuccessResponseOK(w, "", data)
+}
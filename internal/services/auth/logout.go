@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/auth"
+	"github.com/KengoWada/meetup-clone/internal/logger"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/store/cache"
+	"github.com/KengoWada/meetup-clone/internal/utils"
+	"github.com/KengoWada/meetup-clone/internal/validate"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type logoutUserPayload struct {
+	RefreshToken string `json:"refreshToken" validate:"required"`
+}
+
+// LogoutUser godoc
+//
+//	@Summary		Log out a user
+//	@Description	Log out a user. The access token used to make the request is revoked and the session the refresh token belongs to is ended
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			payload	body		logoutUserPayload					true	"log out payload"
+//	@Success		200		{object}	response.DocsResponseMessageOnly	"user successfully logged out"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/auth/logout [post]
+func (h *Handler) logoutUser(w http.ResponseWriter, r *http.Request) {
+	var payload logoutUserPayload
+	if err := utils.ReadJSON(w, r, &payload); err != nil {
+		response.ErrorResponseInvalidJSON(w, r, err)
+		return
+	}
+
+	if errResponse, err := validate.ValidatePayload(payload, validate.FieldErrorMessages{}); err != nil {
+		switch err {
+		case validate.ErrFailedValidation:
+			errorMessage := response.NewValidationErrorResponse(errResponse)
+			response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+	claims, _ := ctx.Value(internal.TokenClaimsCtx).(jwt.MapClaims)
+
+	expiresAt, err := claims.GetExpirationTime()
+	if err != nil || expiresAt == nil {
+		err := errors.New("token has no valid exp claim")
+		response.ErrorResponseUnauthorized(w, r, err)
+		return
+	}
+
+	jti, _ := claims["jti"].(string)
+	revokedToken := &models.RevokedToken{
+		JTI:       jti,
+		UserID:    user.ID,
+		ExpiresAt: expiresAt.UTC().Format(internal.DateTimeFormat),
+	}
+	if err := h.store.RevokedTokens.Create(ctx, revokedToken); err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	if cfg.CacheConfig.Enabled {
+		if err := h.cacheStore.RevokedTokens.Set(jti, true, cache.CacheTTLRevokedToken); err != nil {
+			logger.ErrLoggerCache(r, err)
+		}
+	}
+
+	// Refresh tokens that don't belong to the user are ignored so that users
+	// can't log each other out.
+	token, err := h.store.RefreshTokens.GetByTokenHash(ctx, auth.HashRefreshToken(payload.RefreshToken))
+	if err != nil && err != store.ErrNotFound {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	if err == nil && token.UserID == user.ID {
+		if err := h.revokeSession(r, token.FamilyID); err != nil {
+			response.ErrorResponseInternalServerErr(w, r, err)
+			return
+		}
+	}
+
+	response.SuccessResponseOK(w, "Logged out", nil)
+}
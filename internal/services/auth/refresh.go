@@ -0,0 +1,184 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/auth"
+	"github.com/KengoWada/meetup-clone/internal/logger"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/utils"
+	"github.com/KengoWada/meetup-clone/internal/validate"
+)
+
+var (
+	errInvalidRefreshToken = errors.New("invalid refresh token")
+	errRefreshTokenReused  = errors.New("rotated refresh token was replayed, token family revoked")
+)
+
+type refreshTokenPayload struct {
+	RefreshToken string `json:"refreshToken" validate:"required"`
+}
+
+// RefreshToken godoc
+//
+//	@Summary		Refresh an access token
+//	@Description	Exchange a refresh token for a new access token and refresh token. Each refresh token can only be used once, and using one again logs out every session that came from the same log in
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			payload	body		refreshTokenPayload						true	"refresh token payload"
+//	@Success		200		{object}	response.DocsSuccessResponseLoginUser	"tokens successfully refreshed"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security
+//	@Router	/auth/refresh [post]
+func (h *Handler) refreshToken(w http.ResponseWriter, r *http.Request) {
+	var payload refreshTokenPayload
+	if err := utils.ReadJSON(w, r, &payload); err != nil {
+		response.ErrorResponseInvalidJSON(w, r, err)
+		return
+	}
+
+	if errResponse, err := validate.ValidatePayload(payload, validate.FieldErrorMessages{}); err != nil {
+		switch err {
+		case validate.ErrFailedValidation:
+			errorMessage := response.NewValidationErrorResponse(errResponse)
+			response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	ctx := r.Context()
+
+	token, err := h.store.RefreshTokens.GetByTokenHash(ctx, auth.HashRefreshToken(payload.RefreshToken))
+	if err != nil {
+		switch err {
+		case store.ErrNotFound:
+			response.ErrorResponseUnauthorized(w, r, errInvalidRefreshToken)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	if token.IsRevoked() || token.IsExpired() {
+		response.ErrorResponseUnauthorized(w, r, errInvalidRefreshToken)
+		return
+	}
+
+	if token.IsRotated() {
+		h.revokeTokenFamily(w, r, token.FamilyID)
+		return
+	}
+
+	fields, values := []string{"id"}, []any{token.UserID}
+	user, err := h.store.Users.Get(ctx, false, fields, values)
+	if err != nil {
+		switch err {
+		case store.ErrNotFound:
+			response.ErrorResponseUnauthorized(w, r, errInvalidRefreshToken)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	if user.IsDeactivated() || !user.IsActive {
+		response.ErrorResponseUnauthorized(w, r, errDeactivatedAccountLogin)
+		return
+	}
+
+	createdAt, _ := time.Parse(internal.DateTimeFormat, token.CreatedAt)
+	if user.IsTokenRevoked(createdAt) {
+		response.ErrorResponseUnauthorized(w, r, errInvalidRefreshToken)
+		return
+	}
+
+	session, err := h.store.Sessions.GetByTokenFamilyID(ctx, token.FamilyID)
+	if err != nil {
+		switch err {
+		case store.ErrNotFound:
+			response.ErrorResponseUnauthorized(w, r, errInvalidRefreshToken)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	if session.IsRevoked() {
+		response.ErrorResponseUnauthorized(w, r, errInvalidRefreshToken)
+		return
+	}
+
+	next, nextValue, err := newRefreshToken(user.ID, token.FamilyID)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	if err := h.store.RefreshTokens.Rotate(ctx, token, next); err != nil {
+		switch err {
+		case store.ErrNotFound:
+			// Another request rotated the token first.
+			h.revokeTokenFamily(w, r, token.FamilyID)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	accessToken, err := h.generateAccessToken(user.ID, session.ID)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	data := response.Response{"token": accessToken, "refreshToken": nextValue}
+	response.SuccessResponseOK(w, "", data)
+}
+
+// revokeTokenFamily ends the session the family of refresh tokens belongs
+// to after one of its rotated tokens was replayed, since either the client
+// or an attacker holds a stolen token.
+func (h *Handler) revokeTokenFamily(w http.ResponseWriter, r *http.Request, familyID string) {
+	if err := h.revokeSession(r, familyID); err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	response.ErrorResponseUnauthorized(w, r, errRefreshTokenReused)
+}
+
+// revokeSession ends the session the family of refresh tokens belongs to,
+// which revokes every token in the family. Families without a session only
+// have their tokens revoked.
+func (h *Handler) revokeSession(r *http.Request, familyID string) error {
+	ctx := r.Context()
+
+	session, err := h.store.Sessions.GetByTokenFamilyID(ctx, familyID)
+	if err != nil {
+		if err == store.ErrNotFound {
+			return h.store.RefreshTokens.RevokeFamily(ctx, familyID)
+		}
+		return err
+	}
+
+	if err := h.store.Sessions.Revoke(ctx, session); err != nil {
+		return err
+	}
+
+	if cfg.CacheConfig.Enabled {
+		if err := h.cacheStore.Sessions.Delete(session.ID); err != nil {
+			logger.ErrLoggerCache(r, err)
+		}
+	}
+
+	return nil
+}
@@ -89,12 +89,12 @@ func (h *Handler) registerUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, err = utils.GenerateToken(user.Email, []byte(cfg.SecretKey))
+	token, err := utils.GenerateToken(user.Email, []byte(cfg.SecretKey))
 	if err != nil {
 		response.ErrorResponseInternalServerErr(w, r, err)
 		return
 	}
-	// TODO: Send email to activate account.
+	h.sendActivationEmail(r, user.Email, token)
 
 	response.SuccessResponseCreated(w, "Done.", nil)
 }
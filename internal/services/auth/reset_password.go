@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/KengoWada/meetup-clone/internal/logger"
 	"github.com/KengoWada/meetup-clone/internal/services/response"
 	"github.com/KengoWada/meetup-clone/internal/store"
 	"github.com/KengoWada/meetup-clone/internal/utils"
@@ -100,6 +101,14 @@ func (h *Handler) resetUserPassword(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Drop the cached user so that tokens issued before the reset are
+	// rejected right away.
+	if cfg.CacheConfig.Enabled {
+		if err := h.cacheStore.Users.Delete(user.ID); err != nil {
+			logger.ErrLoggerCache(r, err)
+		}
+	}
+
 	response.SuccessResponseOK(w, "Password successfully updated", nil)
 }
 
@@ -173,7 +182,7 @@ func (h *Handler) passwordResetRequest(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// TODO: send email to user
+	h.sendPasswordResetEmail(r, user.Email, token)
 
 	response.SuccessResponseOK(w, message, nil)
 }
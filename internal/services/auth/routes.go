@@ -5,6 +5,7 @@ import (
 
 	"github.com/KengoWada/meetup-clone/internal/auth"
 	"github.com/KengoWada/meetup-clone/internal/config"
+	"github.com/KengoWada/meetup-clone/internal/mailer"
 	"github.com/KengoWada/meetup-clone/internal/middleware"
 	"github.com/KengoWada/meetup-clone/internal/store"
 	"github.com/KengoWada/meetup-clone/internal/store/cache"
@@ -17,15 +18,22 @@ type Handler struct {
 	store         store.Store
 	cacheStore    cache.Store
 	authenticator auth.Authenticator
+	mailQueue     *mailer.Queue
 }
 
-func NewHandler(store store.Store, cacheStore cache.Store, authenticator auth.Authenticator) *Handler {
-	return &Handler{store, cacheStore, authenticator}
+func NewHandler(store store.Store, cacheStore cache.Store, authenticator auth.Authenticator, mailQueue *mailer.Queue) *Handler {
+	return &Handler{store, cacheStore, authenticator, mailQueue}
 }
 
 func (h *Handler) RegisterRoutes() http.Handler {
 	mux := chi.NewRouter()
 
+	mux.Group(func(r chi.Router) {
+		r.Use(middleware.AuthenticatedRoute)
+
+		r.Post("/logout", h.logoutUser)
+	})
+
 	mux.Group(func(r chi.Router) {
 		r.Use(middleware.AuthenticatedRoute)
 		r.Use(middleware.IsStaffOrAdmin)
@@ -35,6 +43,8 @@ func (h *Handler) RegisterRoutes() http.Handler {
 
 	mux.Post("/register", h.registerUser)
 	mux.Post("/login", h.loginUser)
+	mux.Post("/login/2fa", h.loginTwoFactor)
+	mux.Post("/refresh", h.refreshToken)
 	mux.Patch("/activate", h.activateUser)
 	mux.Post("/resend-verification-email", h.resendVerificationEmail)
 	mux.Post("/password-reset-request", h.passwordResetRequest)
@@ -11,6 +11,8 @@ import (
 	"github.com/KengoWada/meetup-clone/internal/models"
 	"github.com/KengoWada/meetup-clone/internal/utils"
 	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -72,6 +74,55 @@ func TestActivateUser(t *testing.T) {
 		assert.Equal(t, "Email successfully verified", response.GetMessage())
 	})
 
+	t.Run("should attach email invites to the activated user", func(t *testing.T) {
+		owner := createTestUser(true)
+		ownerUser, err := appItems.App.Store.Users.GetWithProfile(ctx, false, []string{"email"}, []any{owner.Email})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ownerRole := &models.Role{
+			Name:        faker.Username(options.WithGenerateUniqueValues(true)),
+			Permissions: internal.Permissions,
+		}
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, true, ownerRole, ownerUser.UserProfile.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		role, err := testutils.CreateTestRole(ctx, appItems.App.Store, false, org.ID, []string{internal.EventCreate})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		testUserData := testutils.NewTestUserData(false)
+		_, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		invite, err := testutils.CreateTestOrganizationEmailInvite(ctx, appItems.App.Store, org.ID, role.ID, testUserData.Email)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data := testutils.TestRequestData{"token": generateToken(testUserData.Email, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint, nil, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		invite, err = appItems.App.Store.OrganizationInvites.Get(ctx, false, []string{"id"}, []any{invite.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Nil(t, invite.Email)
+		if assert.NotNil(t, invite.UserProfileID) {
+			assert.Equal(t, userProfile.ID, *invite.UserProfileID)
+		}
+	})
+
 	t.Run("should not activate if the request has an unknown field", func(t *testing.T) {
 		testUserData := createTestUser(false)
 
@@ -61,6 +61,42 @@ func TestLoginUser(t *testing.T) {
 		assert.True(t, ok)
 		_, err = appItems.App.Authenticator.ValidateToken(token.(string))
 		assert.Nil(t, err)
+
+		refreshToken, ok := data["refreshToken"].(string)
+		assert.True(t, ok)
+		assert.NotEmpty(t, refreshToken)
+	})
+
+	t.Run("should return a challenge when two-factor authentication is enabled", func(t *testing.T) {
+		testUserData := testutils.NewTestUserData(true)
+		user, _, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, _, err := testutils.EnableTestTwoFactor(ctx, appItems.App.Store, user.ID, []byte(appItems.App.Config.SecretKey)); err != nil {
+			t.Fatal(err)
+		}
+
+		data := testutils.TestRequestData{"email": testUserData.Email, "password": testUserData.Password}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint, nil, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusAccepted, response.StatusCode())
+		assert.Equal(t, "Two-factor authentication required", response.GetMessage())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		challengeToken, ok := data["challengeToken"].(string)
+		assert.True(t, ok)
+		assert.NotEmpty(t, challengeToken)
+
+		_, ok = data["token"]
+		assert.False(t, ok)
 	})
 
 	t.Run("should not log in with no credentials provided", func(t *testing.T) {
@@ -0,0 +1,222 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/auth"
+	"github.com/KengoWada/meetup-clone/internal/middleware"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoginTwoFactor(t *testing.T) {
+	testEndpoint := "/v1/auth/login/2fa"
+	testMethod := http.MethodPost
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	// createTestUser creates a user with two-factor authentication enabled
+	// and returns them along with their TOTP secret and recovery codes.
+	createTestUser := func() (testutils.TestUserData, string, []string) {
+		testUserData := testutils.NewTestUserData(true)
+		user, _, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		secret, recoveryCodes, err := testutils.EnableTestTwoFactor(ctx, appItems.App.Store, user.ID, []byte(appItems.App.Config.SecretKey))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return testUserData, secret, recoveryCodes
+	}
+
+	login := func(t *testing.T, testUserData testutils.TestUserData) string {
+		data := testutils.TestRequestData{"email": testUserData.Email, "password": testUserData.Password}
+		response, err := testutils.RunTestRequest(mux, http.MethodPost, "/v1/auth/login", nil, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusAccepted, response.StatusCode())
+
+		responseData, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		challengeToken, _ := responseData["challengeToken"].(string)
+		return challengeToken
+	}
+
+	generateCode := func(secret string) string {
+		code, err := auth.GenerateTOTP(secret, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return code
+	}
+
+	assertLoggedIn := func(t *testing.T, response *testutils.TestRequestResponse) {
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		token, ok := data["token"].(string)
+		assert.True(t, ok)
+		_, err = appItems.App.Authenticator.ValidateToken(token)
+		assert.Nil(t, err)
+
+		refreshToken, ok := data["refreshToken"].(string)
+		assert.True(t, ok)
+		assert.NotEmpty(t, refreshToken)
+	}
+
+	t.Run("should log in with a code", func(t *testing.T) {
+		testUserData, secret, _ := createTestUser()
+		challengeToken := login(t, testUserData)
+
+		data := testutils.TestRequestData{"challengeToken": challengeToken, "code": generateCode(secret)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint, nil, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertLoggedIn(t, response)
+
+		// The challenge can only be used once.
+		response, err = testutils.RunTestRequest(mux, testMethod, testEndpoint, nil, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+		assert.Equal(t, "unauthorized", response.GetMessage())
+	})
+
+	t.Run("should log in with a recovery code once", func(t *testing.T) {
+		testUserData, _, recoveryCodes := createTestUser()
+
+		data := testutils.TestRequestData{"challengeToken": login(t, testUserData), "code": recoveryCodes[0]}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint, nil, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertLoggedIn(t, response)
+
+		data = testutils.TestRequestData{"challengeToken": login(t, testUserData), "code": recoveryCodes[0]}
+		response, err = testutils.RunTestRequest(mux, testMethod, testEndpoint, nil, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invalid two-factor authentication code", response.GetMessage())
+	})
+
+	t.Run("should not accept a code twice", func(t *testing.T) {
+		testUserData, secret, _ := createTestUser()
+		code := generateCode(secret)
+
+		data := testutils.TestRequestData{"challengeToken": login(t, testUserData), "code": code}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint, nil, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertLoggedIn(t, response)
+
+		data = testutils.TestRequestData{"challengeToken": login(t, testUserData), "code": code}
+		response, err = testutils.RunTestRequest(mux, testMethod, testEndpoint, nil, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invalid two-factor authentication code", response.GetMessage())
+	})
+
+	t.Run("should not log in after too many invalid codes", func(t *testing.T) {
+		testUserData, secret, _ := createTestUser()
+		challengeToken := login(t, testUserData)
+
+		data := testutils.TestRequestData{"challengeToken": challengeToken, "code": "abcde-fghij"}
+		for range 5 {
+			response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint, nil, data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+			assert.Equal(t, "Invalid two-factor authentication code", response.GetMessage())
+		}
+
+		data = testutils.TestRequestData{"challengeToken": challengeToken, "code": generateCode(secret)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint, nil, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+		assert.Equal(t, "unauthorized", response.GetMessage())
+	})
+
+	t.Run("should lock a user out after too many invalid codes", func(t *testing.T) {
+		testUserData, secret, _ := createTestUser()
+
+		// Each challenge allows a few attempts, so the limit is per user.
+		for range 5 {
+			data := testutils.TestRequestData{"challengeToken": login(t, testUserData), "code": "000000"}
+			response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint, nil, data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+			assert.Equal(t, "Invalid two-factor authentication code", response.GetMessage())
+		}
+
+		data := testutils.TestRequestData{"challengeToken": login(t, testUserData), "code": generateCode(secret)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint, nil, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, middleware.TooManyTwoFactorAttemptsMessage, response.GetMessage())
+	})
+
+	t.Run("should not log in with an invalid challenge token", func(t *testing.T) {
+		_, secret, _ := createTestUser()
+
+		data := testutils.TestRequestData{"challengeToken": "invalid-challenge", "code": generateCode(secret)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint, nil, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+		assert.Equal(t, "unauthorized", response.GetMessage())
+	})
+
+	t.Run("should not log in with no payload", func(t *testing.T) {
+		data := testutils.TestRequestData{"challengeToken": "", "code": ""}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint, nil, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invalid request body", response.GetMessage())
+
+		errorMessages, ok := response.GetErrorMessages()
+		if !ok {
+			t.Fatal("failed to convert response errors to map")
+		}
+		assert.Equal(t, "Field is required", errorMessages["challengeToken"])
+		assert.Equal(t, "Field is required", errorMessages["code"])
+	})
+}
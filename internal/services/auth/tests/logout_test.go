@@ -0,0 +1,183 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogoutUser(t *testing.T) {
+	testEndpoint := "/v1/auth/logout"
+	testMethod := http.MethodPost
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func() testutils.TestUserData {
+		testUserData := testutils.NewTestUserData(true)
+		_, _, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return testUserData
+	}
+
+	login := func(t *testing.T, testUserData testutils.TestUserData) (string, string) {
+		data := testutils.TestRequestData{"email": testUserData.Email, "password": testUserData.Password}
+		response, err := testutils.RunTestRequest(mux, http.MethodPost, "/v1/auth/login", nil, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		responseData, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		token, _ := responseData["token"].(string)
+		refreshToken, _ := responseData["refreshToken"].(string)
+		return token, refreshToken
+	}
+
+	t.Run("should log out a user", func(t *testing.T) {
+		testUserData := createTestUser()
+		token, refreshToken := login(t, testUserData)
+		otherToken, otherRefreshToken := login(t, testUserData)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + token}
+		data := testutils.TestRequestData{"refreshToken": refreshToken}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint, headers, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "Logged out", response.GetMessage())
+
+		response, err = testutils.RunTestRequest(mux, http.MethodGet, "/v1/organizations", headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+
+		data = testutils.TestRequestData{"refreshToken": refreshToken}
+		response, err = testutils.RunTestRequest(mux, http.MethodPost, "/v1/auth/refresh", nil, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+
+		// Other log ins are not affected.
+		headers = testutils.TestRequestHeaders{"Authorization": "Bearer " + otherToken}
+		response, err = testutils.RunTestRequest(mux, http.MethodGet, "/v1/organizations", headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		data = testutils.TestRequestData{"refreshToken": otherRefreshToken}
+		response, err = testutils.RunTestRequest(mux, http.MethodPost, "/v1/auth/refresh", nil, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+	})
+
+	t.Run("should purge expired revoked tokens", func(t *testing.T) {
+		testUserData := createTestUser()
+		token, refreshToken := login(t, testUserData)
+
+		fields, values := []string{"email"}, []any{testUserData.Email}
+		user, err := appItems.App.Store.Users.Get(ctx, false, fields, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expiredToken := &models.RevokedToken{
+			JTI:       "expired-revoked-token-" + strconv.FormatInt(user.ID, 10),
+			UserID:    user.ID,
+			ExpiresAt: time.Now().Add(-time.Minute).UTC().Format(internal.DateTimeFormat),
+		}
+		err = appItems.App.Store.RevokedTokens.Create(ctx, expiredToken)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + token}
+		data := testutils.TestRequestData{"refreshToken": refreshToken}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint, headers, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		isRevoked, err := appItems.App.Store.RevokedTokens.IsRevoked(ctx, expiredToken.JTI)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.False(t, isRevoked)
+	})
+
+	t.Run("should not revoke another user's refresh token", func(t *testing.T) {
+		token, _ := login(t, createTestUser())
+		_, otherRefreshToken := login(t, createTestUser())
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + token}
+		data := testutils.TestRequestData{"refreshToken": otherRefreshToken}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint, headers, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		data = testutils.TestRequestData{"refreshToken": otherRefreshToken}
+		response, err = testutils.RunTestRequest(mux, http.MethodPost, "/v1/auth/refresh", nil, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+	})
+
+	t.Run("should not log out with no refresh token provided", func(t *testing.T) {
+		token, _ := login(t, createTestUser())
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + token}
+		data := testutils.TestRequestData{"refreshToken": ""}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint, headers, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+
+		errorMessages, ok := response.GetErrorMessages()
+		if !ok {
+			t.Fatal("failed to convert response errors to map")
+		}
+		assert.Equal(t, "Field is required", errorMessages["refreshToken"])
+	})
+
+	t.Run("should not log out not authenticated", func(t *testing.T) {
+		_, refreshToken := login(t, createTestUser())
+
+		data := testutils.TestRequestData{"refreshToken": refreshToken}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint, nil, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+		assert.Equal(t, "unauthorized", response.GetMessage())
+	})
+}
@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefreshToken(t *testing.T) {
+	testEndpoint := "/v1/auth/refresh"
+	testMethod := http.MethodPost
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func() testutils.TestUserData {
+		testUserData := testutils.NewTestUserData(true)
+		_, _, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return testUserData
+	}
+
+	getTokens := func(t *testing.T, response *testutils.TestRequestResponse) (string, string) {
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		token, _ := data["token"].(string)
+		refreshToken, _ := data["refreshToken"].(string)
+		return token, refreshToken
+	}
+
+	login := func(t *testing.T, testUserData testutils.TestUserData) string {
+		data := testutils.TestRequestData{"email": testUserData.Email, "password": testUserData.Password}
+		response, err := testutils.RunTestRequest(mux, http.MethodPost, "/v1/auth/login", nil, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, refreshToken := getTokens(t, response)
+		return refreshToken
+	}
+
+	refresh := func(t *testing.T, refreshToken string) *testutils.TestRequestResponse {
+		data := testutils.TestRequestData{"refreshToken": refreshToken}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint, nil, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return response
+	}
+
+	t.Run("should refresh tokens", func(t *testing.T) {
+		refreshToken := login(t, createTestUser())
+
+		response := refresh(t, refreshToken)
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		token, nextRefreshToken := getTokens(t, response)
+		_, err := appItems.App.Authenticator.ValidateToken(token)
+		assert.Nil(t, err)
+		assert.NotEmpty(t, nextRefreshToken)
+		assert.NotEqual(t, refreshToken, nextRefreshToken)
+
+		response = refresh(t, nextRefreshToken)
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+	})
+
+	t.Run("should revoke token family when a rotated token is reused", func(t *testing.T) {
+		refreshToken := login(t, createTestUser())
+
+		response := refresh(t, refreshToken)
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		_, nextRefreshToken := getTokens(t, response)
+
+		response = refresh(t, refreshToken)
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+		assert.Equal(t, "unauthorized", response.GetMessage())
+
+		response = refresh(t, nextRefreshToken)
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+		assert.Equal(t, "unauthorized", response.GetMessage())
+	})
+
+	t.Run("should not revoke other token families when a token is reused", func(t *testing.T) {
+		testUserData := createTestUser()
+		refreshToken := login(t, testUserData)
+		otherRefreshToken := login(t, testUserData)
+
+		response := refresh(t, refreshToken)
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		response = refresh(t, refreshToken)
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+
+		response = refresh(t, otherRefreshToken)
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+	})
+
+	t.Run("should not refresh with invalid token", func(t *testing.T) {
+		response := refresh(t, "invalid-refresh-token")
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+		assert.Equal(t, "unauthorized", response.GetMessage())
+	})
+
+	t.Run("should not refresh with no token provided", func(t *testing.T) {
+		response := refresh(t, "")
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invalid request body", response.GetMessage())
+
+		errorMessages, ok := response.GetErrorMessages()
+		if !ok {
+			t.Fatal("failed to convert response errors to map")
+		}
+		assert.Equal(t, "Field is required", errorMessages["refreshToken"])
+	})
+}
@@ -3,6 +3,7 @@ package tests
 import (
 	"context"
 	"net/http"
+	"strconv"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/KengoWada/meetup-clone/internal/models"
 	"github.com/KengoWada/meetup-clone/internal/utils"
 	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -70,6 +72,104 @@ func TestResetUserPassword(t *testing.T) {
 		assert.Equal(t, "Password successfully updated", response.GetMessage())
 	})
 
+	t.Run("should revoke tokens issued before the password reset", func(t *testing.T) {
+		testUserData, token := createTestUserAndSetPasswordResetToken(true, false)
+
+		fields, values := []string{"email"}, []any{testUserData.Email}
+		user, err := appItems.App.Store.Users.Get(ctx, false, fields, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		issuedAt := time.Now().Add(-time.Minute)
+		session, err := testutils.CreateTestSession(ctx, appItems.App.Store, user.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		accessToken, err := appItems.App.Authenticator.GenerateToken(jwt.MapClaims{
+			"jti": "reset-password-test-" + strconv.FormatInt(user.ID, 10),
+			"sub": user.ID,
+			"sid": session.ID,
+			"exp": time.Now().Add(time.Minute * 10).Unix(),
+			"iat": issuedAt.Unix(),
+			"nbf": issuedAt.Unix(),
+			"aud": appItems.App.Config.AuthConfig.Audience,
+			"iss": appItems.App.Config.AuthConfig.Issuer,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + accessToken}
+		response, err := testutils.RunTestRequest(mux, http.MethodGet, "/v1/organizations", headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		data := testutils.TestRequestData{"token": token, "password": testUserData.Password}
+		response, err = testutils.RunTestRequest(mux, testMethod, testEndpoint, nil, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		response, err = testutils.RunTestRequest(mux, http.MethodGet, "/v1/organizations", headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+		assert.Equal(t, "unauthorized", response.GetMessage())
+	})
+
+	t.Run("should revoke tokens issued in the same second as the password reset", func(t *testing.T) {
+		testUserData, token := createTestUserAndSetPasswordResetToken(true, false)
+
+		fields, values := []string{"email"}, []any{testUserData.Email}
+		user, err := appItems.App.Store.Users.Get(ctx, false, fields, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Token issue times are only precise to the second, so the reset
+		// below happens in the same second as or after the issue time.
+		issuedAt := time.Now()
+		session, err := testutils.CreateTestSession(ctx, appItems.App.Store, user.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		accessToken, err := appItems.App.Authenticator.GenerateToken(jwt.MapClaims{
+			"jti": "reset-password-same-second-test-" + strconv.FormatInt(user.ID, 10),
+			"sub": user.ID,
+			"sid": session.ID,
+			"exp": issuedAt.Add(time.Minute * 10).Unix(),
+			"iat": issuedAt.Unix(),
+			"nbf": issuedAt.Unix(),
+			"aud": appItems.App.Config.AuthConfig.Audience,
+			"iss": appItems.App.Config.AuthConfig.Issuer,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data := testutils.TestRequestData{"token": token, "password": testUserData.Password}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint, nil, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + accessToken}
+		response, err = testutils.RunTestRequest(mux, http.MethodGet, "/v1/organizations", headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+		assert.Equal(t, "unauthorized", response.GetMessage())
+	})
+
 	t.Run("should not reset password unknown field", func(t *testing.T) {
 		testUserData, token := createTestUserAndSetPasswordResetToken(true, false)
 
@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/auth"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// generateAccessToken creates a short-lived JWT for the user's session. Each
+// token gets a unique jti claim so that it can be revoked before it expires,
+// and a sid claim so that it stops working once its session is revoked.
+func (h *Handler) generateAccessToken(userID, sessionID int64) (string, error) {
+	jti, err := auth.GenerateTokenID()
+	if err != nil {
+		return "", err
+	}
+
+	exp := time.Minute * time.Duration(cfg.AuthConfig.Exp)
+	claims := jwt.MapClaims{
+		"jti": jti,
+		"sub": userID,
+		"sid": sessionID,
+		"exp": time.Now().Add(exp).Unix(),
+		"iat": time.Now().Unix(),
+		"nbf": time.Now().Unix(),
+		"iss": cfg.AuthConfig.Issuer,
+		"aud": cfg.AuthConfig.Audience,
+	}
+
+	return h.authenticator.GenerateToken(claims)
+}
+
+// newRefreshToken creates a refresh token for the user in the family. It
+// returns the token to store along with the token to hand to the client.
+func newRefreshToken(userID int64, familyID string) (*models.RefreshToken, string, error) {
+	token, hash, err := auth.GenerateRefreshToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	exp := time.Hour * time.Duration(cfg.AuthConfig.RefreshExp)
+	refreshToken := &models.RefreshToken{
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: hash,
+		ExpiresAt: time.Now().UTC().Add(exp).Format(internal.DateTimeFormat),
+	}
+
+	return refreshToken, token, nil
+}
+
+// startSession logs the user in on the device the request came from. It
+// creates the session along with its first refresh token and returns the
+// access token and refresh token to hand to the client.
+func (h *Handler) startSession(r *http.Request, userID int64, device string) (string, string, error) {
+	familyID, err := auth.GenerateTokenFamily()
+	if err != nil {
+		return "", "", err
+	}
+
+	refreshToken, refreshTokenValue, err := newRefreshToken(userID, familyID)
+	if err != nil {
+		return "", "", err
+	}
+
+	session := &models.UserSession{
+		UserID:        userID,
+		TokenFamilyID: familyID,
+		Device:        device,
+		IPAddress:     clientIP(r),
+		UserAgent:     r.UserAgent(),
+	}
+	if err := h.store.Sessions.Create(r.Context(), session, refreshToken); err != nil {
+		return "", "", err
+	}
+
+	token, err := h.generateAccessToken(userID, session.ID)
+	if err != nil {
+		return "", "", err
+	}
+
+	return token, refreshTokenValue, nil
+}
+
+// clientIP returns the IP address the request came from. The RealIP
+// middleware has already replaced the remote address with the forwarded IP
+// when the request came through a proxy.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
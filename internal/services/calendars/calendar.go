@@ -0,0 +1,156 @@
+package calendars
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/ical"
+	"github.com/KengoWada/meetup-clone/internal/models"
+)
+
+// eventUID returns the UID an event keeps in every feed it is part of.
+// Occurrences of a recurring event share the UID of the event.
+func eventUID(eventID int64) string {
+	return fmt.Sprintf("event-%d@meetup-clone", eventID)
+}
+
+func newOrganizationCalendar(org *models.Organization, events []*models.Event, overrides map[int64][]*models.EventOccurrenceOverride) ical.Calendar {
+	calendar := ical.Calendar{Name: org.Name}
+	for _, event := range events {
+		calendar.Events = append(calendar.Events, newCalendarEvent(event))
+		for _, override := range overrides[event.ID] {
+			calendar.Events = append(calendar.Events, newOverriddenCalendarEvent(event, override))
+		}
+	}
+
+	return calendar
+}
+
+// newPersonalCalendar returns a calendar of the events the user RSVP'd to.
+// RSVPs to a single occurrence of a recurring event only add that
+// occurrence to the calendar.
+func newPersonalCalendar(rsvps []*models.EventRSVP, overrides map[int64][]*models.EventOccurrenceOverride) ical.Calendar {
+	calendar := ical.Calendar{Name: "My events"}
+	for _, rsvp := range rsvps {
+		event := rsvp.Event
+		if rsvp.OccurrenceStart == nil {
+			calendar.Events = append(calendar.Events, newCalendarEvent(event))
+			continue
+		}
+
+		occurrenceStart := parseDateTime(*rsvp.OccurrenceStart)
+		isExcluded := slices.ContainsFunc(event.ExDates, func(exDate string) bool {
+			return parseDateTime(exDate).Equal(occurrenceStart)
+		})
+		if !event.IsRecurring() || isExcluded {
+			continue
+		}
+
+		index := slices.IndexFunc(overrides[event.ID], func(override *models.EventOccurrenceOverride) bool {
+			return parseDateTime(override.OccurrenceStart).Equal(occurrenceStart)
+		})
+		if index >= 0 {
+			calendar.Events = append(calendar.Events, newOverriddenCalendarEvent(event, overrides[event.ID][index]))
+			continue
+		}
+
+		calendar.Events = append(calendar.Events, newOccurrenceCalendarEvent(event, *rsvp.OccurrenceStart))
+	}
+
+	return calendar
+}
+
+// newCalendarEvent returns the event as it appears in calendars. Recurring
+// events are returned as the whole series.
+func newCalendarEvent(event *models.Event) ical.Event {
+	calendarEvent := ical.Event{
+		UID:         eventUID(event.ID),
+		Sequence:    event.Version,
+		Stamp:       parseDateTime(lastModified(event.BaseModel)),
+		Start:       parseDateTime(event.StartTime),
+		End:         parseDateTime(event.EndTime),
+		Summary:     event.Title,
+		Description: event.Description,
+		Location:    event.Location,
+		URL:         event.OnlineURL,
+		Status:      eventStatus(event),
+		RRule:       event.RRule,
+	}
+
+	for _, exDate := range event.ExDates {
+		calendarEvent.ExDates = append(calendarEvent.ExDates, parseDateTime(exDate))
+	}
+
+	return calendarEvent
+}
+
+// newOccurrenceCalendarEvent returns a single occurrence of the recurring
+// event that has not been overridden.
+func newOccurrenceCalendarEvent(event *models.Event, occurrenceStart string) ical.Event {
+	start := parseDateTime(occurrenceStart)
+	duration := parseDateTime(event.EndTime).Sub(parseDateTime(event.StartTime))
+
+	return ical.Event{
+		UID:          eventUID(event.ID),
+		Sequence:     event.Version,
+		Stamp:        parseDateTime(lastModified(event.BaseModel)),
+		RecurrenceID: &start,
+		Start:        start,
+		End:          start.Add(duration),
+		Summary:      event.Title,
+		Description:  event.Description,
+		Location:     event.Location,
+		URL:          event.OnlineURL,
+		Status:       eventStatus(event),
+	}
+}
+
+// newOverriddenCalendarEvent returns an overridden occurrence of the
+// recurring event. Its sequence grows with changes to either the event or
+// the override.
+func newOverriddenCalendarEvent(event *models.Event, override *models.EventOccurrenceOverride) ical.Event {
+	recurrenceID := parseDateTime(override.OccurrenceStart)
+	stamp := parseDateTime(lastModified(event.BaseModel))
+	if overrideStamp := parseDateTime(lastModified(override.BaseModel)); overrideStamp.After(stamp) {
+		stamp = overrideStamp
+	}
+
+	return ical.Event{
+		UID:          eventUID(event.ID),
+		Sequence:     event.Version + override.Version,
+		Stamp:        stamp,
+		RecurrenceID: &recurrenceID,
+		Start:        parseDateTime(override.StartTime),
+		End:          parseDateTime(override.EndTime),
+		Summary:      override.Title,
+		Description:  override.Description,
+		Location:     override.Location,
+		URL:          override.OnlineURL,
+		Status:       eventStatus(event),
+	}
+}
+
+func eventStatus(event *models.Event) ical.Status {
+	if event.IsCancelled() {
+		return ical.StatusCancelled
+	}
+
+	return ical.StatusConfirmed
+}
+
+func lastModified(model models.BaseModel) string {
+	if model.UpdatedAt != nil {
+		return *model.UpdatedAt
+	}
+
+	return model.CreatedAt
+}
+
+// parseDateTime parses date times read from the database, which are always
+// in the internal.DateTimeFormat.
+func parseDateTime(value string) time.Time {
+	dateTime, _ := time.Parse(internal.DateTimeFormat, value)
+	return dateTime
+}
@@ -0,0 +1,236 @@
+package calendars
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+type calendarFeedResponse struct {
+	Token string `json:"token"`
+	URL   string `json:"url"`
+}
+
+// CreateCalendarFeed godoc
+//
+//	@Summary		Create a personal calendar feed
+//	@Description	Create a calendar feed of the events you RSVP'd to. Creating a new feed revokes the previous one
+//	@Tags			calendars
+//	@Accept			json
+//	@Produce		json
+//	@Success		201	{object}	calendarFeedResponse	"calendar feed successfully created"
+//	@Failure		401	{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		500	{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/calendars/feed [post]
+func (h *Handler) createCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+
+	feed := &models.CalendarFeed{UserProfileID: user.UserProfile.ID}
+	if err := h.store.CalendarFeeds.Create(ctx, feed); err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	token, err := utils.GeneratePurposeToken(utils.TokenPurposeCalendarFeed, strconv.FormatInt(feed.ID, 10), []byte(cfg.SecretKey))
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	data := calendarFeedResponse{
+		Token: token,
+		URL:   fmt.Sprintf("%s/v1/calendars/feeds/%s.ics", cfg.ApiURL, token),
+	}
+	response.SuccessResponseCreated(w, "Done", data)
+}
+
+// RevokeCalendarFeed godoc
+//
+//	@Summary		Revoke your personal calendar feed
+//	@Description	Revoke your personal calendar feed so that its URL stops working
+//	@Tags			calendars
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	response.DocsResponseMessageOnly	"calendar feed successfully revoked"
+//	@Failure		400	{object}	response.DocsErrorResponse
+//	@Failure		401	{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		500	{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/calendars/feed [delete]
+func (h *Handler) revokeCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+
+	fields, values := []string{"user_id"}, []any{user.UserProfile.ID}
+	feed, err := h.store.CalendarFeeds.Get(ctx, false, fields, values)
+	if err != nil {
+		switch err {
+		case store.ErrNotFound:
+			errorMessage := response.ErrorResponse{Message: "You do not have a calendar feed"}
+			response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	if err := h.store.CalendarFeeds.SoftDelete(ctx, feed); err != nil {
+		switch err {
+		case store.ErrNotFound:
+			res := response.ErrorResponse{Message: "Try again later"}
+			response.ErrorResponseBadRequest(w, r, err, res)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	response.SuccessResponseOK(w, "Done", nil)
+}
+
+// GetOrganizationCalendar godoc
+//
+//	@Summary		Get an organization's calendar feed
+//	@Description	Get an iCalendar feed of an organization's published events
+//	@Tags			calendars
+//	@Produce		text/calendar
+//	@Param			orgID	path		int		true	"orgID whose events to fetch"
+//	@Success		200		{string}	string	"iCalendar feed"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		403		{object}	response.DocsErrorResponseForbidden
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security
+//	@Router	/calendars/organizations/{orgID}.ics [get]
+func (h *Handler) getOrganizationCalendar(w http.ResponseWriter, r *http.Request) {
+	orgID, err := strconv.ParseInt(chi.URLParam(r, "orgID"), 10, 64)
+	if err != nil {
+		errorMessage := response.ErrorResponse{Message: "Invalid organization ID"}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	ctx := r.Context()
+	fields, values := []string{"id", "is_active"}, []any{orgID, true}
+	org, err := h.store.Organizations.Get(ctx, false, fields, values)
+	if err != nil {
+		switch err {
+		case store.ErrNotFound:
+			response.ErrorResponseForbidden(w, r, err)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	events, err := h.store.Events.GetPublishedByOrgID(ctx, org.ID)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	overrides, err := h.getOverrides(r, events)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	calendar := newOrganizationCalendar(org, events, overrides)
+	response.SuccessResponseCalendar(w, calendar.Bytes())
+}
+
+// GetPersonalCalendar godoc
+//
+//	@Summary		Get your personal calendar feed
+//	@Description	Get an iCalendar feed of the events you are going to, might go to, or are waitlisted for. The feed is authenticated by the token in its URL
+//	@Tags			calendars
+//	@Produce		text/calendar
+//	@Param			token	path		string	true	"calendar feed token"
+//	@Success		200		{string}	string	"iCalendar feed"
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security
+//	@Router	/calendars/feeds/{token}.ics [get]
+func (h *Handler) getPersonalCalendar(w http.ResponseWriter, r *http.Request) {
+	timedToken, err := utils.ValidatePurposeToken(chi.URLParam(r, "token"), utils.TokenPurposeCalendarFeed, []byte(cfg.SecretKey), utils.NoExpiry)
+	if err != nil {
+		response.ErrorResponseUnauthorized(w, r, err)
+		return
+	}
+
+	feedID, err := strconv.ParseInt(timedToken.Body, 10, 64)
+	if err != nil {
+		response.ErrorResponseUnauthorized(w, r, err)
+		return
+	}
+
+	ctx := r.Context()
+	fields, values := []string{"id"}, []any{feedID}
+	feed, err := h.store.CalendarFeeds.Get(ctx, false, fields, values)
+	if err != nil {
+		switch err {
+		case store.ErrNotFound:
+			err := errors.New("calendar feed has been revoked")
+			response.ErrorResponseUnauthorized(w, r, err)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	rsvps, err := h.store.EventRSVPs.GetByUserID(ctx, feed.UserProfileID)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	events := make([]*models.Event, len(rsvps))
+	for index, rsvp := range rsvps {
+		events[index] = rsvp.Event
+	}
+
+	overrides, err := h.getOverrides(r, events)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	calendar := newPersonalCalendar(rsvps, overrides)
+	response.SuccessResponseCalendar(w, calendar.Bytes())
+}
+
+// getOverrides returns the overridden occurrences of the recurring events,
+// keyed by event ID.
+func (h *Handler) getOverrides(r *http.Request, events []*models.Event) (map[int64][]*models.EventOccurrenceOverride, error) {
+	var eventIDs []int64
+	for _, event := range events {
+		if event.IsRecurring() {
+			eventIDs = append(eventIDs, event.ID)
+		}
+	}
+
+	overridesByEvent := make(map[int64][]*models.EventOccurrenceOverride)
+	if len(eventIDs) == 0 {
+		return overridesByEvent, nil
+	}
+
+	overrides, err := h.store.EventOccurrenceOverrides.GetByEventIDs(r.Context(), eventIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, override := range overrides {
+		overridesByEvent[override.EventID] = append(overridesByEvent[override.EventID], override)
+	}
+
+	return overridesByEvent, nil
+}
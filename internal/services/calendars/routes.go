@@ -0,0 +1,38 @@
+package calendars
+
+import (
+	"net/http"
+
+	"github.com/KengoWada/meetup-clone/internal/config"
+	"github.com/KengoWada/meetup-clone/internal/middleware"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/go-chi/chi/v5"
+)
+
+var cfg = config.Get()
+
+type Handler struct {
+	store store.Store
+}
+
+func NewHandler(store store.Store) *Handler {
+	return &Handler{store}
+}
+
+func (h *Handler) RegisterRoutes() http.Handler {
+	mux := chi.NewRouter()
+
+	mux.Group(func(r chi.Router) {
+		r.Use(middleware.AuthenticatedRoute)
+
+		r.Post("/feed", h.createCalendarFeed)
+		r.Delete("/feed", h.revokeCalendarFeed)
+	})
+
+	// Calendar clients can not send an Authorization header so the feeds
+	// are either public or authenticated by the token in their URL.
+	mux.Get("/organizations/{orgID}.ics", h.getOrganizationCalendar)
+	mux.Get("/feeds/{token}.ics", h.getPersonalCalendar)
+
+	return mux
+}
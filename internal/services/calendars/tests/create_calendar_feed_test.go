@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateCalendarFeed(t *testing.T) {
+	testEndpoint := "/v1/calendars/feed"
+	testMethod := http.MethodPost
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	createFeed := func(user *models.User) map[string]any {
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(user.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusCreated, response.StatusCode())
+		assert.Equal(t, "Done", response.GetMessage())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		return data
+	}
+
+	getFeed := func(token string) *testutils.TestRequestResponse {
+		endpoint := "/v1/calendars/feeds/" + token + ".ics"
+		response, err := testutils.RunTestRequest(mux, http.MethodGet, endpoint, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return response
+	}
+
+	t.Run("should create calendar feed", func(t *testing.T) {
+		testUser := createTestUser(true)
+
+		data := createFeed(testUser)
+		assert.NotEmpty(t, data["token"])
+		assert.Contains(t, data["url"], "/v1/calendars/feeds/")
+
+		response := getFeed(data["token"].(string))
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+	})
+
+	t.Run("should revoke previous feed when creating a new one", func(t *testing.T) {
+		testUser := createTestUser(true)
+
+		oldToken := createFeed(testUser)["token"].(string)
+		newToken := createFeed(testUser)["token"].(string)
+
+		response := getFeed(oldToken)
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+
+		response = getFeed(newToken)
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+	})
+
+	t.Run("should not create calendar feed when not logged in", func(t *testing.T) {
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+	})
+}
@@ -0,0 +1,123 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOrganizationCalendar(t *testing.T) {
+	testEndpoint := func(orgID int64) string {
+		return fmt.Sprintf("/v1/calendars/organizations/%d.ics", orgID)
+	}
+	testMethod := http.MethodGet
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestOrg := func(isActive bool) (*models.Organization, *models.User) {
+		testUserData := testutils.NewTestUserData(true)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+		user.UserProfile = userProfile
+
+		role := &models.Role{
+			Name:        faker.Username(options.WithGenerateUniqueValues(true)),
+			Permissions: []string{internal.EventCreate},
+		}
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, isActive, role, userProfile.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org, user
+	}
+
+	t.Run("should get organization calendar", func(t *testing.T) {
+		testOrg, testUser := createTestOrg(true)
+		publishedEvent, err := testutils.CreateTestPublishedEvent(ctx, appItems.App.Store, false, testOrg.ID, testUser.UserProfile.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		draftEvent, err := testutils.CreateTestEvent(ctx, appItems.App.Store, false, testOrg.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID), nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "text/calendar; charset=utf-8", response.Response.Header().Get("Content-Type"))
+
+		body := response.Response.Body.String()
+		assert.Contains(t, body, "BEGIN:VCALENDAR\r\n")
+		assert.Contains(t, body, fmt.Sprintf("UID:event-%d@meetup-clone\r\n", publishedEvent.ID))
+		assert.Contains(t, body, fmt.Sprintf("SEQUENCE:%d\r\n", publishedEvent.Version))
+		assert.Contains(t, body, "SUMMARY:"+publishedEvent.Title)
+		assert.NotContains(t, body, fmt.Sprintf("UID:event-%d@meetup-clone\r\n", draftEvent.ID))
+	})
+
+	t.Run("should include recurrence rules and overridden occurrences", func(t *testing.T) {
+		testOrg, testUser := createTestOrg(true)
+		testEvent, err := testutils.CreateTestRecurringEvent(ctx, appItems.App.Store, testOrg.ID, testUser.UserProfile.ID, "FREQ=WEEKLY;COUNT=3")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		override := &models.EventOccurrenceOverride{
+			EventID:         testEvent.ID,
+			OccurrenceStart: testEvent.StartTime,
+			Title:           "Overridden occurrence",
+			Description:     testEvent.Description,
+			StartTime:       testEvent.StartTime,
+			EndTime:         testEvent.EndTime,
+			Location:        testEvent.Location,
+			Capacity:        testEvent.Capacity,
+		}
+		if err := appItems.App.Store.EventOccurrenceOverrides.Save(ctx, override); err != nil {
+			t.Fatal(err)
+		}
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID), nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		body := response.Response.Body.String()
+		assert.Contains(t, body, "RRULE:FREQ=WEEKLY;COUNT=3\r\n")
+		assert.Contains(t, body, "RECURRENCE-ID:")
+		assert.Contains(t, body, "SUMMARY:Overridden occurrence\r\n")
+	})
+
+	t.Run("should not get calendar of inactive organization", func(t *testing.T) {
+		testOrg, _ := createTestOrg(false)
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID), nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+}
@@ -0,0 +1,162 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPersonalCalendar(t *testing.T) {
+	testEndpoint := func(token string) string {
+		return fmt.Sprintf("/v1/calendars/feeds/%s.ics", token)
+	}
+	testMethod := http.MethodGet
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func(userID int64) *models.Organization {
+		role := &models.Role{
+			Name:        faker.Username(options.WithGenerateUniqueValues(true)),
+			Permissions: []string{internal.EventCreate},
+		}
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, true, role, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org
+	}
+
+	createFeedToken := func(user *models.User) string {
+		feed := &models.CalendarFeed{UserProfileID: user.UserProfile.ID}
+		if err := appItems.App.Store.CalendarFeeds.Create(ctx, feed); err != nil {
+			t.Fatal(err)
+		}
+
+		token, err := utils.GeneratePurposeToken(utils.TokenPurposeCalendarFeed, fmt.Sprint(feed.ID), []byte(appItems.App.Config.SecretKey))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	t.Run("should get personal calendar", func(t *testing.T) {
+		organizer := createTestUser(true)
+		testOrg := createTestOrg(organizer.UserProfile.ID)
+		goingEvent, err := testutils.CreateTestPublishedEvent(ctx, appItems.App.Store, false, testOrg.ID, organizer.UserProfile.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		notGoingEvent, err := testutils.CreateTestPublishedEvent(ctx, appItems.App.Store, false, testOrg.ID, organizer.UserProfile.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		testUser := createTestUser(true)
+		if _, err := testutils.CreateTestRSVP(ctx, appItems.App.Store, goingEvent.ID, testUser.UserProfile.ID, models.RSVPGoing); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := testutils.CreateTestRSVP(ctx, appItems.App.Store, notGoingEvent.ID, testUser.UserProfile.ID, models.RSVPNotGoing); err != nil {
+			t.Fatal(err)
+		}
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(createFeedToken(testUser)), nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		body := response.Response.Body.String()
+		assert.Contains(t, body, fmt.Sprintf("UID:event-%d@meetup-clone\r\n", goingEvent.ID))
+		assert.NotContains(t, body, fmt.Sprintf("UID:event-%d@meetup-clone\r\n", notGoingEvent.ID))
+	})
+
+	t.Run("should get occurrences of recurring events", func(t *testing.T) {
+		organizer := createTestUser(true)
+		testOrg := createTestOrg(organizer.UserProfile.ID)
+		testEvent, err := testutils.CreateTestRecurringEvent(ctx, appItems.App.Store, testOrg.ID, organizer.UserProfile.ID, "FREQ=DAILY;COUNT=5")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		testUser := createTestUser(true)
+		rsvp := &models.EventRSVP{
+			EventID:         testEvent.ID,
+			UserProfileID:   testUser.UserProfile.ID,
+			OccurrenceStart: &testEvent.StartTime,
+			Status:          models.RSVPGoing,
+		}
+		if err := appItems.App.Store.EventRSVPs.Create(ctx, rsvp); err != nil {
+			t.Fatal(err)
+		}
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(createFeedToken(testUser)), nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		body := response.Response.Body.String()
+		assert.Contains(t, body, fmt.Sprintf("UID:event-%d@meetup-clone\r\n", testEvent.ID))
+		assert.Contains(t, body, "RECURRENCE-ID:")
+		assert.NotContains(t, body, "RRULE:")
+	})
+
+	t.Run("should not get personal calendar with invalid token", func(t *testing.T) {
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint("invalid-token"), nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+	})
+
+	t.Run("should not get personal calendar with token not issued for feeds", func(t *testing.T) {
+		testUser := createTestUser(true)
+		feed := &models.CalendarFeed{UserProfileID: testUser.UserProfile.ID}
+		if err := appItems.App.Store.CalendarFeeds.Create(ctx, feed); err != nil {
+			t.Fatal(err)
+		}
+
+		token, err := utils.GenerateToken(fmt.Sprint(feed.ID), []byte(appItems.App.Config.SecretKey))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(token), nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+	})
+}
@@ -0,0 +1,88 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevokeCalendarFeed(t *testing.T) {
+	testEndpoint := "/v1/calendars/feed"
+	testMethod := http.MethodDelete
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	t.Run("should revoke calendar feed", func(t *testing.T) {
+		testUser := createTestUser(true)
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+
+		response, err := testutils.RunTestRequest(mux, http.MethodPost, testEndpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusCreated, response.StatusCode())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		response, err = testutils.RunTestRequest(mux, testMethod, testEndpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "Done", response.GetMessage())
+
+		endpoint := "/v1/calendars/feeds/" + data["token"].(string) + ".ics"
+		response, err = testutils.RunTestRequest(mux, http.MethodGet, endpoint, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+	})
+
+	t.Run("should not revoke calendar feed that does not exist", func(t *testing.T) {
+		testUser := createTestUser(true)
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "You do not have a calendar feed", response.GetMessage())
+	})
+}
@@ -0,0 +1,160 @@
+package events
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/utils"
+)
+
+const (
+	// defaultFeedWindow is how far ahead the feed looks for events when the
+	// client does not say.
+	defaultFeedWindow = time.Hour * 24 * 90
+	// defaultFeedLimit is how many events are on a page when the client
+	// does not say.
+	defaultFeedLimit = 20
+	// maxFeedLimit is the most events a client can ask for on a page.
+	maxFeedLimit = 100
+)
+
+// GetEvents godoc
+//
+//	@Summary		Discover events
+//	@Description	Get the published events of all organizations, sorted by start time. Recurring events are placed by the start of their series and are returned until their last occurrence has started. Pass the returned nextCursor as the cursor to get the next page
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Param			from	query		string				false	"only return events that start at or after this time, defaults to now"
+//	@Param			to		query		string				false	"only return events that start at or before this time, defaults to 90 days after from"
+//	@Param			topic	query		string				false	"only return events with this topic"
+//	@Param			org_id	query		int					false	"only return events hosted by this organization"
+//	@Param			format	query		string				false	"only return online or in person events"	Enums(online, in_person)
+//	@Param			q		query		string				false	"only return events whose title or description match this text"
+//	@Param			limit	query		int					false	"number of events on a page, defaults to 20 and is at most 100"
+//	@Param			cursor	query		string				false	"cursor of the page to fetch"
+//	@Success		200		{object}	[]models.FeedEvent	"events successfully fetched"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Router			/events [get]
+func (h *Handler) getEvents(w http.ResponseWriter, r *http.Request) {
+	filter, err := parseFeedQuery(r)
+	if err != nil {
+		errorMessage := response.ErrorResponse{Message: err.Error()}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	// Fetch an extra event to find out if there is another page.
+	limit := filter.Limit
+	filter.Limit++
+
+	events, err := h.store.Events.GetPublished(r.Context(), filter)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	var nextCursor *string
+	if len(events) > limit {
+		events = events[:limit]
+		last := events[limit-1]
+
+		cursor, err := utils.EncodeCursor(store.EventCursor{StartTime: last.StartTime, ID: last.ID})
+		if err != nil {
+			response.ErrorResponseInternalServerErr(w, r, err)
+			return
+		}
+		nextCursor = &cursor
+	}
+
+	if events == nil {
+		events = []*models.FeedEvent{}
+	}
+
+	data := map[string]any{"events": events, "nextCursor": nextCursor}
+	response.SuccessResponseOK(w, "", data)
+}
+
+func parseFeedQuery(r *http.Request) (store.EventFilter, error) {
+	query := r.URL.Query()
+
+	from := time.Now().UTC().Truncate(time.Second)
+	if value := query.Get("from"); value != "" {
+		parsed, err := time.Parse(internal.DateTimeFormat, value)
+		if err != nil {
+			return store.EventFilter{}, errors.New("Invalid from date time. yyyy-mm-ddThh:mm:ssZ")
+		}
+		from = parsed
+	}
+
+	to := from.Add(defaultFeedWindow)
+	if value := query.Get("to"); value != "" {
+		parsed, err := time.Parse(internal.DateTimeFormat, value)
+		if err != nil {
+			return store.EventFilter{}, errors.New("Invalid to date time. yyyy-mm-ddThh:mm:ssZ")
+		}
+		to = parsed
+	}
+
+	if to.Before(from) {
+		return store.EventFilter{}, errors.New("To date time should be after from date time")
+	}
+
+	filter := store.EventFilter{
+		From:  from.Format(internal.DateTimeFormat),
+		To:    to.Format(internal.DateTimeFormat),
+		Topic: strings.ToLower(strings.TrimSpace(query.Get("topic"))),
+		Query: strings.TrimSpace(query.Get("q")),
+		Limit: defaultFeedLimit,
+	}
+
+	if value := query.Get("org_id"); value != "" {
+		orgID, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || orgID < 1 {
+			return store.EventFilter{}, errors.New("Invalid organization ID")
+		}
+		filter.OrganizationID = orgID
+	}
+
+	switch query.Get("format") {
+	case "":
+	case "online":
+		online := true
+		filter.Online = &online
+	case "in_person":
+		online := false
+		filter.Online = &online
+	default:
+		return store.EventFilter{}, errors.New("Format should be one of online or in_person")
+	}
+
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 1 || limit > maxFeedLimit {
+			return store.EventFilter{}, errors.New("Limit should be a number between 1 and 100")
+		}
+		filter.Limit = limit
+	}
+
+	if value := query.Get("cursor"); value != "" {
+		var cursor store.EventCursor
+		if err := utils.DecodeCursor(value, &cursor); err != nil {
+			return store.EventFilter{}, errors.New("Invalid cursor")
+		}
+
+		if _, err := time.Parse(internal.DateTimeFormat, cursor.StartTime); err != nil {
+			return store.EventFilter{}, errors.New("Invalid cursor")
+		}
+		filter.After = &cursor
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,124 @@
+package events
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/rrule"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+)
+
+const (
+	// defaultRadiusKm is how far to look for events when the client does
+	// not say.
+	defaultRadiusKm = 25.0
+	// maxRadiusKm is the furthest a client can look for events.
+	maxRadiusKm = 500.0
+)
+
+// GetNearbyEvents godoc
+//
+//	@Summary		Get events near a location
+//	@Description	Get the published upcoming events held at venues within radius_km of a location, closest first
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Param			lat			query		number					true	"latitude of the location"
+//	@Param			lng			query		number					true	"longitude of the location"
+//	@Param			radius_km	query		number					false	"how far from the location to look, defaults to 25 and is at most 500"
+//	@Success		200			{object}	[]models.NearbyEvent	"nearby events successfully fetched"
+//	@Failure		400			{object}	response.DocsErrorResponse
+//	@Failure		401			{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		500			{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/events/nearby [get]
+func (h *Handler) getNearbyEvents(w http.ResponseWriter, r *http.Request) {
+	latitude, longitude, radiusKm, err := parseNearbyQuery(r)
+	if err != nil {
+		errorMessage := response.ErrorResponse{Message: err.Error()}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	events, err := h.store.Events.GetNearby(r.Context(), latitude, longitude, radiusKm)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	now := time.Now()
+	upcoming := make([]*models.NearbyEvent, 0, len(events))
+	for _, event := range events {
+		nextStart, ok := nextEventStart(event, now)
+		if !ok {
+			continue
+		}
+
+		event.NextStartTime = nextStart.UTC().Format(internal.DateTimeFormat)
+		upcoming = append(upcoming, event)
+	}
+
+	response.SuccessResponseOK(w, "", map[string]any{"events": upcoming})
+}
+
+func parseNearbyQuery(r *http.Request) (float64, float64, float64, error) {
+	query := r.URL.Query()
+
+	latitude, err := strconv.ParseFloat(query.Get("lat"), 64)
+	if err != nil || !isFinite(latitude) || latitude < -90 || latitude > 90 {
+		return 0, 0, 0, errors.New("Latitude should be a number between -90 and 90")
+	}
+
+	longitude, err := strconv.ParseFloat(query.Get("lng"), 64)
+	if err != nil || !isFinite(longitude) || longitude < -180 || longitude > 180 {
+		return 0, 0, 0, errors.New("Longitude should be a number between -180 and 180")
+	}
+
+	radiusKm := defaultRadiusKm
+	if value := query.Get("radius_km"); value != "" {
+		radiusKm, err = strconv.ParseFloat(value, 64)
+		if err != nil || !isFinite(radiusKm) || radiusKm <= 0 || radiusKm > maxRadiusKm {
+			return 0, 0, 0, errors.New("Radius should be a number greater than 0 and at most 500")
+		}
+	}
+
+	return latitude, longitude, radiusKm, nil
+}
+
+// isFinite reports whether value is neither NaN nor infinite. ParseFloat
+// accepts "NaN" and "Inf", and NaN passes every range check.
+func isFinite(value float64) bool {
+	return !math.IsNaN(value) && !math.IsInf(value, 0)
+}
+
+// nextEventStart returns when the event, or the next occurrence of a
+// recurring event, starts. It returns false when the event has no upcoming
+// occurrences.
+func nextEventStart(event *models.NearbyEvent, now time.Time) (time.Time, bool) {
+	start, err := time.Parse(internal.DateTimeFormat, event.StartTime)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	if event.RRule == "" {
+		return start, start.After(now)
+	}
+
+	rule, err := rrule.Parse(event.RRule)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return rule.Next(start, now, func(occurrence time.Time) bool {
+		return slices.ContainsFunc(event.ExDates, func(exDate string) bool {
+			excluded, err := time.Parse(internal.DateTimeFormat, exDate)
+			return err == nil && excluded.Equal(occurrence)
+		})
+	})
+}
@@ -0,0 +1,262 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEvents(t *testing.T) {
+	testEndpoint := func(query url.Values) string {
+		return "/v1/events?" + query.Encode()
+	}
+	testMethod := http.MethodGet
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func(isActive bool, userID int64) *models.Organization {
+		role := &models.Role{Name: faker.Username(options.WithGenerateUniqueValues(true)), Permissions: internal.Permissions}
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, isActive, role, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org
+	}
+
+	createTestEvent := func(orgID, userProfileID int64, startsIn time.Duration, publish bool, update func(*models.Event)) *models.Event {
+		title := faker.Username(options.WithGenerateUniqueValues(true))
+		startTime, endTime := testutils.GenerateEventTimes(startsIn)
+		event := &models.Event{
+			OrganizationID: orgID,
+			Title:          title,
+			Description:    fmt.Sprintf("%s Description", title),
+			StartTime:      startTime,
+			EndTime:        endTime,
+			Location:       "Kampala, Uganda",
+			Capacity:       10,
+		}
+		if update != nil {
+			update(event)
+		}
+
+		if err := appItems.App.Store.Events.Create(ctx, event); err != nil {
+			t.Fatal(err)
+		}
+
+		if publish {
+			if err := appItems.App.Store.Events.Publish(ctx, event, userProfileID); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		return event
+	}
+
+	getEvents := func(query url.Values) ([]int64, *string) {
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(query), nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		events, ok := data["events"].([]any)
+		if !ok {
+			t.Fatal("failed to convert events to slice")
+		}
+
+		eventIDs := make([]int64, 0, len(events))
+		for _, item := range events {
+			eventData, _ := item.(map[string]any)
+			eventIDs = append(eventIDs, int64(eventData["id"].(float64)))
+		}
+
+		var nextCursor *string
+		if cursor, ok := data["nextCursor"].(string); ok {
+			nextCursor = &cursor
+		}
+
+		return eventIDs, nextCursor
+	}
+
+	t.Run("should fetch published events sorted by start time", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, testUser.UserProfile.ID)
+
+		laterEvent := createTestEvent(testOrg.ID, testUser.UserProfile.ID, time.Hour*48, true, nil)
+		soonerEvent := createTestEvent(testOrg.ID, testUser.UserProfile.ID, time.Hour*24, true, nil)
+		draftEvent := createTestEvent(testOrg.ID, testUser.UserProfile.ID, time.Hour*24, false, nil)
+		pastEvent := createTestEvent(testOrg.ID, testUser.UserProfile.ID, -time.Hour*24, true, nil)
+
+		cancelledEvent := createTestEvent(testOrg.ID, testUser.UserProfile.ID, time.Hour*24, true, nil)
+		cancelledEvent.CancellationReason = "Cancelled for testing"
+		notification := &models.Notification{Type: models.NotificationEventCancelled, Message: cancelledEvent.CancellationReason}
+		if err := appItems.App.Store.Events.Cancel(ctx, cancelledEvent, notification); err != nil {
+			t.Fatal(err)
+		}
+
+		query := url.Values{"org_id": {fmt.Sprint(testOrg.ID)}}
+		eventIDs, nextCursor := getEvents(query)
+		assert.Equal(t, []int64{soonerEvent.ID, laterEvent.ID}, eventIDs)
+		assert.NotContains(t, eventIDs, draftEvent.ID)
+		assert.NotContains(t, eventIDs, pastEvent.ID)
+		assert.NotContains(t, eventIDs, cancelledEvent.ID)
+		assert.Nil(t, nextCursor)
+	})
+
+	t.Run("should page through events without duplicates", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, testUser.UserProfile.ID)
+
+		// Events that start at the same time are sorted by ID.
+		var expectedIDs []int64
+		for range 5 {
+			event := createTestEvent(testOrg.ID, testUser.UserProfile.ID, time.Hour*24, true, nil)
+			expectedIDs = append(expectedIDs, event.ID)
+		}
+
+		query := url.Values{"org_id": {fmt.Sprint(testOrg.ID)}, "limit": {"2"}}
+		var eventIDs []int64
+		for range 3 {
+			pageIDs, nextCursor := getEvents(query)
+			eventIDs = append(eventIDs, pageIDs...)
+			if nextCursor == nil {
+				break
+			}
+
+			query.Set("cursor", *nextCursor)
+		}
+
+		assert.Equal(t, expectedIDs, eventIDs)
+	})
+
+	t.Run("should filter events", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, testUser.UserProfile.ID)
+
+		topic := faker.Username(options.WithGenerateUniqueValues(true))
+		topicEvent := createTestEvent(testOrg.ID, testUser.UserProfile.ID, time.Hour*24, true, func(event *models.Event) {
+			event.Topics = []string{topic}
+		})
+		onlineEvent := createTestEvent(testOrg.ID, testUser.UserProfile.ID, time.Hour*48, true, func(event *models.Event) {
+			event.Location = ""
+			event.OnlineURL = "https://meet.fake.link/event"
+		})
+		laterEvent := createTestEvent(testOrg.ID, testUser.UserProfile.ID, time.Hour*24*10, true, nil)
+
+		orgID := fmt.Sprint(testOrg.ID)
+		testCases := []struct {
+			query    url.Values
+			expected []int64
+		}{
+			{url.Values{"org_id": {orgID}, "topic": {topic}}, []int64{topicEvent.ID}},
+			{url.Values{"org_id": {orgID}, "format": {"online"}}, []int64{onlineEvent.ID}},
+			{url.Values{"org_id": {orgID}, "format": {"in_person"}}, []int64{topicEvent.ID, laterEvent.ID}},
+			{url.Values{"org_id": {orgID}, "q": {onlineEvent.Title}}, []int64{onlineEvent.ID}},
+			{
+				url.Values{
+					"org_id": {orgID},
+					"from":   {time.Now().Add(time.Hour * 24 * 5).UTC().Format(internal.DateTimeFormat)},
+				},
+				[]int64{laterEvent.ID},
+			},
+			{
+				url.Values{
+					"org_id": {orgID},
+					"to":     {time.Now().Add(time.Hour * 36).UTC().Format(internal.DateTimeFormat)},
+				},
+				[]int64{topicEvent.ID},
+			},
+		}
+
+		for _, testCase := range testCases {
+			eventIDs, _ := getEvents(testCase.query)
+			assert.Equal(t, testCase.expected, eventIDs, testCase.query.Encode())
+		}
+	})
+
+	t.Run("should fetch ongoing recurring events", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, testUser.UserProfile.ID)
+
+		ongoingEvent := createTestEvent(testOrg.ID, testUser.UserProfile.ID, -time.Hour*24*8, true, func(event *models.Event) {
+			event.RRule = "FREQ=WEEKLY"
+		})
+		endingEvent := createTestEvent(testOrg.ID, testUser.UserProfile.ID, -time.Hour*24*8, true, func(event *models.Event) {
+			event.RRule = "FREQ=WEEKLY;COUNT=3"
+		})
+		endedEvent := createTestEvent(testOrg.ID, testUser.UserProfile.ID, -time.Hour*24*8, true, func(event *models.Event) {
+			event.RRule = "FREQ=WEEKLY;COUNT=2"
+		})
+
+		eventIDs, _ := getEvents(url.Values{"org_id": {fmt.Sprint(testOrg.ID)}})
+		assert.Equal(t, []int64{ongoingEvent.ID, endingEvent.ID}, eventIDs)
+		assert.NotContains(t, eventIDs, endedEvent.ID)
+	})
+
+	t.Run("should not fetch events of inactive organizations", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(false, testUser.UserProfile.ID)
+		createTestEvent(testOrg.ID, testUser.UserProfile.ID, time.Hour*24, true, nil)
+
+		eventIDs, _ := getEvents(url.Values{"org_id": {fmt.Sprint(testOrg.ID)}})
+		assert.Empty(t, eventIDs)
+	})
+
+	t.Run("should not fetch events with invalid query", func(t *testing.T) {
+		testCases := []struct {
+			query        url.Values
+			errorMessage string
+		}{
+			{url.Values{"from": {"yesterday"}}, "Invalid from date time. yyyy-mm-ddThh:mm:ssZ"},
+			{url.Values{"to": {"2020-01-01T00:00:00Z"}}, "To date time should be after from date time"},
+			{url.Values{"org_id": {"invalid"}}, "Invalid organization ID"},
+			{url.Values{"format": {"hybrid"}}, "Format should be one of online or in_person"},
+			{url.Values{"limit": {"101"}}, "Limit should be a number between 1 and 100"},
+			{url.Values{"cursor": {"invalid"}}, "Invalid cursor"},
+		}
+
+		for _, testCase := range testCases {
+			response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testCase.query), nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+			assert.Equal(t, testCase.errorMessage, response.GetMessage())
+		}
+	})
+}
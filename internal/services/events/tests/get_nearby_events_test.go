@@ -0,0 +1,285 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetNearbyEvents(t *testing.T) {
+	testEndpoint := func(latitude, longitude float64, radiusKm string) string {
+		endpoint := fmt.Sprintf("/v1/events/nearby?lat=%f&lng=%f", latitude, longitude)
+		if radiusKm != "" {
+			endpoint += "&radius_km=" + radiusKm
+		}
+
+		return endpoint
+	}
+	testMethod := http.MethodGet
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func(isActive bool, userID int64) *models.Organization {
+		role := &models.Role{Name: faker.Username(options.WithGenerateUniqueValues(true)), Permissions: internal.Permissions}
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, isActive, role, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org
+	}
+
+	createTestVenue := func(orgID int64, latitude, longitude float64) *models.Venue {
+		venue, err := testutils.CreateTestVenue(ctx, appItems.App.Store, false, orgID, latitude, longitude)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return venue
+	}
+
+	createTestVenueEvent := func(orgID, userProfileID, venueID int64, startsIn time.Duration) *models.Event {
+		event, err := testutils.CreateTestVenueEvent(ctx, appItems.App.Store, orgID, userProfileID, venueID, startsIn)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return event
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	getEventIDs := func(response *testutils.TestRequestResponse) []int64 {
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		events, ok := data["events"].([]any)
+		if !ok {
+			t.Fatal("failed to convert events to slice")
+		}
+
+		eventIDs := make([]int64, 0, len(events))
+		for _, item := range events {
+			eventData, _ := item.(map[string]any)
+			eventIDs = append(eventIDs, int64(eventData["id"].(float64)))
+		}
+
+		return eventIDs
+	}
+
+	t.Run("should fetch nearby events closest first", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, testUser.UserProfile.ID)
+
+		latitude, longitude := testutils.GenerateCoordinates()
+		// Roughly 10km, 1km and 100km north of the location.
+		farVenue := createTestVenue(testOrg.ID, latitude+0.09, longitude)
+		nearVenue := createTestVenue(testOrg.ID, latitude+0.009, longitude)
+		outOfRangeVenue := createTestVenue(testOrg.ID, latitude+0.9, longitude)
+
+		farEvent := createTestVenueEvent(testOrg.ID, testUser.UserProfile.ID, farVenue.ID, time.Hour*24)
+		nearEvent := createTestVenueEvent(testOrg.ID, testUser.UserProfile.ID, nearVenue.ID, time.Hour*48)
+		outOfRangeEvent := createTestVenueEvent(testOrg.ID, testUser.UserProfile.ID, outOfRangeVenue.ID, time.Hour*24)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(latitude, longitude, ""), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		eventIDs := getEventIDs(response)
+		assert.Equal(t, []int64{nearEvent.ID, farEvent.ID}, eventIDs)
+		assert.NotContains(t, eventIDs, outOfRangeEvent.ID)
+
+		data, _ := response.GetData()
+		events, _ := data["events"].([]any)
+		nearest, _ := events[0].(map[string]any)
+		venue, _ := nearest["venue"].(map[string]any)
+		assert.EqualValues(t, nearVenue.ID, venue["id"])
+		assert.InDelta(t, 1.0, nearest["distanceKm"], 0.1)
+		nearEventStart, _ := time.Parse(internal.DateTimeFormat, nearEvent.StartTime)
+		nextStart, _ := time.Parse(internal.DateTimeFormat, nearest["nextStartTime"].(string))
+		assert.True(t, nearEventStart.Equal(nextStart))
+
+		response, err = testutils.RunTestRequest(mux, testMethod, testEndpoint(latitude, longitude, "150"), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, []int64{nearEvent.ID, farEvent.ID, outOfRangeEvent.ID}, getEventIDs(response))
+	})
+
+	t.Run("should only fetch published upcoming events", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, testUser.UserProfile.ID)
+
+		latitude, longitude := testutils.GenerateCoordinates()
+		venue := createTestVenue(testOrg.ID, latitude, longitude)
+
+		upcomingEvent := createTestVenueEvent(testOrg.ID, testUser.UserProfile.ID, venue.ID, time.Hour*24)
+		pastEvent := createTestVenueEvent(testOrg.ID, testUser.UserProfile.ID, venue.ID, -time.Hour*24)
+
+		cancelledEvent := createTestVenueEvent(testOrg.ID, testUser.UserProfile.ID, venue.ID, time.Hour*24)
+		cancelledEvent.CancellationReason = "Cancelled for testing"
+		notification := &models.Notification{Type: models.NotificationEventCancelled, Message: cancelledEvent.CancellationReason}
+		if err := appItems.App.Store.Events.Cancel(ctx, cancelledEvent, notification); err != nil {
+			t.Fatal(err)
+		}
+
+		draftEvent, err := testutils.CreateTestEvent(ctx, appItems.App.Store, false, testOrg.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		draftEvent.VenueID = &venue.ID
+		if err := appItems.App.Store.Events.Update(ctx, draftEvent); err != nil {
+			t.Fatal(err)
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(latitude, longitude, "5"), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		eventIDs := getEventIDs(response)
+		assert.Equal(t, []int64{upcomingEvent.ID}, eventIDs)
+		assert.NotContains(t, eventIDs, pastEvent.ID)
+		assert.NotContains(t, eventIDs, cancelledEvent.ID)
+		assert.NotContains(t, eventIDs, draftEvent.ID)
+	})
+
+	t.Run("should fetch recurring events with upcoming occurrences", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, testUser.UserProfile.ID)
+
+		latitude, longitude := testutils.GenerateCoordinates()
+		venue := createTestVenue(testOrg.ID, latitude, longitude)
+
+		ongoingEvent := createTestVenueEvent(testOrg.ID, testUser.UserProfile.ID, venue.ID, -time.Hour*24)
+		ongoingEvent.RRule = "FREQ=DAILY;COUNT=5"
+		if err := appItems.App.Store.Events.Update(ctx, ongoingEvent); err != nil {
+			t.Fatal(err)
+		}
+
+		finishedEvent := createTestVenueEvent(testOrg.ID, testUser.UserProfile.ID, venue.ID, -time.Hour*72)
+		finishedEvent.RRule = "FREQ=DAILY;COUNT=2"
+		if err := appItems.App.Store.Events.Update(ctx, finishedEvent); err != nil {
+			t.Fatal(err)
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(latitude, longitude, "5"), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, []int64{ongoingEvent.ID}, getEventIDs(response))
+
+		start, _ := time.Parse(internal.DateTimeFormat, ongoingEvent.StartTime)
+		data, _ := response.GetData()
+		events, _ := data["events"].([]any)
+		event, _ := events[0].(map[string]any)
+		nextStart, _ := time.Parse(internal.DateTimeFormat, event["nextStartTime"].(string))
+		assert.True(t, start.Add(time.Hour*24).Equal(nextStart))
+	})
+
+	t.Run("should not fetch recurring events whose series has ended", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, testUser.UserProfile.ID)
+
+		latitude, longitude := testutils.GenerateCoordinates()
+		venue := createTestVenue(testOrg.ID, latitude, longitude)
+
+		finishedEvent := createTestVenueEvent(testOrg.ID, testUser.UserProfile.ID, venue.ID, -time.Hour*72)
+		finishedEvent.RRule = "FREQ=DAILY;COUNT=2"
+		if err := appItems.App.Store.Events.Update(ctx, finishedEvent); err != nil {
+			t.Fatal(err)
+		}
+
+		// Ended series are left out by the query, so they don't count
+		// against its limit.
+		events, err := appItems.App.Store.Events.GetNearby(ctx, latitude, longitude, 5)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, event := range events {
+			assert.NotEqual(t, finishedEvent.ID, event.ID)
+		}
+	})
+
+	t.Run("should not fetch nearby events with invalid query", func(t *testing.T) {
+		testUser := createTestUser(true)
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+
+		testCases := []struct {
+			endpoint     string
+			errorMessage string
+		}{
+			{"/v1/events/nearby?lng=32.58", "Latitude should be a number between -90 and 90"},
+			{"/v1/events/nearby?lat=91&lng=32.58", "Latitude should be a number between -90 and 90"},
+			{"/v1/events/nearby?lat=NaN&lng=32.58", "Latitude should be a number between -90 and 90"},
+			{"/v1/events/nearby?lat=0.34&lng=invalid", "Longitude should be a number between -180 and 180"},
+			{"/v1/events/nearby?lat=0.34&lng=NaN", "Longitude should be a number between -180 and 180"},
+			{"/v1/events/nearby?lat=0.34&lng=32.58&radius_km=0", "Radius should be a number greater than 0 and at most 500"},
+			{"/v1/events/nearby?lat=0.34&lng=32.58&radius_km=501", "Radius should be a number greater than 0 and at most 500"},
+			{"/v1/events/nearby?lat=0.34&lng=32.58&radius_km=NaN", "Radius should be a number greater than 0 and at most 500"},
+			{"/v1/events/nearby?lat=0.34&lng=32.58&radius_km=Inf", "Radius should be a number greater than 0 and at most 500"},
+		}
+
+		for _, testCase := range testCases {
+			response, err := testutils.RunTestRequest(mux, testMethod, testCase.endpoint, headers, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+			assert.Equal(t, testCase.errorMessage, response.GetMessage())
+		}
+	})
+
+	t.Run("should not fetch nearby events when not authenticated", func(t *testing.T) {
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(0.34, 32.58, ""), nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+	})
+}
@@ -18,11 +18,12 @@ type createOrganizationPayload struct {
 }
 
 type orgResponse struct {
-	ID          int64  `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	ProfilePic  string `json:"profilePic"`
-	CreatedAt   string `json:"createdAt"`
+	ID          int64                 `json:"id"`
+	Name        string                `json:"name"`
+	Description string                `json:"description"`
+	ProfilePic  string                `json:"profilePic"`
+	CreatedAt   string                `json:"createdAt"`
+	Events      []*models.SimpleEvent `json:"events,omitempty"`
 }
 
 // CreateOrganization godoc
@@ -0,0 +1,90 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/utils"
+	"github.com/KengoWada/meetup-clone/internal/validate"
+)
+
+type cancelEventPayload struct {
+	Reason utils.TrimString `json:"reason" validate:"required,max=500"`
+}
+
+// CancelOrganizationEvent godoc
+//
+//	@Summary		Cancel an organization event
+//	@Description	Cancel a published organization event and notify everyone who RSVP'd to it
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID	path		int					true	"orgID the event belongs to"
+//	@Param			eventID	path		int					true	"eventID to cancel"
+//	@Param			payload	body		cancelEventPayload	true	"cancel organization event payload"
+//	@Success		200		{object}	models.SimpleEvent	"event successfully cancelled"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403		{object}	response.DocsErrorResponseForbidden
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/events/{eventID}/cancel [patch]
+func (h *Handler) cancelEvent(w http.ResponseWriter, r *http.Request) {
+	var payload cancelEventPayload
+	if err := utils.ReadJSON(w, r, &payload); err != nil {
+		response.ErrorResponseInvalidJSON(w, r, err)
+		return
+	}
+
+	if errorMessages, err := validate.ValidatePayload(payload, cancelEventPayloadErrors); err != nil {
+		switch err {
+		case validate.ErrFailedValidation:
+			errorResponse := response.NewValidationErrorResponse(errorMessages)
+			response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	ctx := r.Context()
+	event, _ := ctx.Value(internal.EventCtx).(*models.Event)
+
+	if !event.IsPublished() {
+		err := errors.New("draft event can not be cancelled")
+		errorMessage := response.ErrorResponse{Message: "Draft events can not be cancelled"}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	if event.IsCancelled() {
+		err := errors.New("event is already cancelled")
+		errorMessage := response.ErrorResponse{Message: "Event is already cancelled"}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	event.CancellationReason = string(payload.Reason)
+	notification := &models.Notification{
+		Type:    models.NotificationEventCancelled,
+		Message: fmt.Sprintf("%s has been cancelled: %s", event.Title, event.CancellationReason),
+	}
+
+	if err := h.store.Events.Cancel(ctx, event, notification); err != nil {
+		switch err {
+		case store.ErrNotFound:
+			res := response.ErrorResponse{Message: "Try again later"}
+			response.ErrorResponseBadRequest(w, r, err, res)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	response.SuccessResponseOK(w, "Done", newSimpleEvent(event))
+}
@@ -0,0 +1,144 @@
+package events
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/utils"
+	"github.com/KengoWada/meetup-clone/internal/validate"
+)
+
+type createEventPayload struct {
+	Title       utils.TrimString `json:"title" validate:"required,max=255"`
+	Description utils.TrimString `json:"description" validate:"required"`
+	StartTime   string           `json:"startTime" validate:"required,is_datetime" example:"2025-03-01T18:00:00Z"`
+	EndTime     string           `json:"endTime" validate:"required,is_datetime" example:"2025-03-01T20:00:00Z"`
+	Location    utils.TrimString `json:"location" validate:"required_without=OnlineURL,max=255"`
+	OnlineURL   utils.TrimString `json:"onlineUrl" validate:"omitempty,http_url"`
+	VenueID     *int64           `json:"venueId" validate:"omitempty,min=1" example:"1"`
+	Capacity    int              `json:"capacity" validate:"required,min=1"`
+	RRule       utils.TrimString `json:"rrule" validate:"omitempty,is_rrule" example:"FREQ=WEEKLY;BYDAY=TU;COUNT=10"`
+	ExDates     []string         `json:"exdates" validate:"omitempty,dive,is_datetime"`
+	Topics      []string         `json:"topics" validate:"omitempty,max=10,dive,required,max=50" example:"golang"`
+}
+
+// CreateOrganizationEvent godoc
+//
+//	@Summary		Create an organization event
+//	@Description	Create an organization event
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID	path		int					true	"orgID to associate event to"
+//	@Param			payload	body		createEventPayload	true	"create organization event payload"
+//	@Success		201		{object}	models.SimpleEvent	"organization event successfully created"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403		{object}	response.DocsErrorResponseForbidden
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/events [post]
+func (h *Handler) createEvent(w http.ResponseWriter, r *http.Request) {
+	var payload createEventPayload
+	if err := utils.ReadJSON(w, r, &payload); err != nil {
+		response.ErrorResponseInvalidJSON(w, r, err)
+		return
+	}
+
+	if errorMessages, err := validate.ValidatePayload(payload, eventPayloadErrors); err != nil {
+		switch err {
+		case validate.ErrFailedValidation:
+			errorResponse := response.NewValidationErrorResponse(errorMessages)
+			response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	if errorMessages := validateEventTimes(payload.StartTime, payload.EndTime); errorMessages != nil {
+		err := errors.New("event end time is before start time")
+		errorResponse := response.NewValidationErrorResponse(errorMessages)
+		response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		return
+	}
+
+	ctx := r.Context()
+	organization, _ := ctx.Value(internal.OrgCtx).(*models.Organization)
+
+	errorMessages, err := validateEventVenue(ctx, h.store, organization.ID, payload.VenueID)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	if errorMessages != nil {
+		err := errors.New("event venue does not belong to the organization")
+		errorResponse := response.NewValidationErrorResponse(errorMessages)
+		response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		return
+	}
+
+	event := &models.Event{
+		OrganizationID: organization.ID,
+		Title:          string(payload.Title),
+		Description:    string(payload.Description),
+		StartTime:      payload.StartTime,
+		EndTime:        payload.EndTime,
+		Location:       string(payload.Location),
+		OnlineURL:      string(payload.OnlineURL),
+		VenueID:        payload.VenueID,
+		Capacity:       payload.Capacity,
+		RRule:          normalizeRRule(string(payload.RRule)),
+		ExDates:        normalizeDateTimes(payload.ExDates),
+		Topics:         normalizeTopics(payload.Topics),
+	}
+
+	if err := h.store.Events.Create(ctx, event); err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	response.SuccessResponseCreated(w, "Done", newSimpleEvent(event))
+}
+
+// newSimpleEvent converts an event into the representation that is
+// returned to clients.
+func newSimpleEvent(event *models.Event) models.SimpleEvent {
+	return models.SimpleEvent{
+		ID:                 event.ID,
+		Title:              event.Title,
+		Description:        event.Description,
+		StartTime:          event.StartTime,
+		EndTime:            event.EndTime,
+		Location:           event.Location,
+		OnlineURL:          event.OnlineURL,
+		VenueID:            event.VenueID,
+		Capacity:           event.Capacity,
+		PublishedAt:        event.PublishedAt,
+		CancelledAt:        event.CancelledAt,
+		CancellationReason: event.CancellationReason,
+		RRule:              event.RRule,
+		ExDates:            event.ExDates,
+		Topics:             event.Topics,
+	}
+}
+
+// normalizeTopics trims and lower cases the topics and drops duplicates so
+// that events can be filtered by topic regardless of how it was written.
+func normalizeTopics(topics []string) []string {
+	normalized := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		topic = strings.ToLower(strings.TrimSpace(topic))
+		if topic != "" && !slices.Contains(normalized, topic) {
+			normalized = append(normalized, topic)
+		}
+	}
+
+	return normalized
+}
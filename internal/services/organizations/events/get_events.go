@@ -0,0 +1,81 @@
+package events
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+)
+
+// GetOrganizationEvent godoc
+//
+//	@Summary		Get an organization event
+//	@Description	Get an organization event. Draft events are only visible to members who can create or update events
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID	path		int					true	"orgID the event belongs to"
+//	@Param			eventID	path		int					true	"eventID to fetch"
+//	@Success		200		{object}	models.SimpleEvent	"event successfully fetched"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403		{object}	response.DocsErrorResponseForbidden
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/events/{eventID} [get]
+func (h *Handler) getEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	event, _ := ctx.Value(internal.EventCtx).(*models.Event)
+
+	if !event.IsPublished() {
+		ok, err := canViewDrafts(r, h.store, h.cacheStore)
+		if err != nil {
+			response.ErrorResponseInternalServerErr(w, r, err)
+			return
+		}
+
+		if !ok {
+			err := errors.New("user does not have permissions to view draft event")
+			response.ErrorResponseForbidden(w, r, err)
+			return
+		}
+	}
+
+	response.SuccessResponseOK(w, "", newSimpleEvent(event))
+}
+
+// GetOrganizationEvents godoc
+//
+//	@Summary		Get an organization's events
+//	@Description	Get an organization's events. Draft events are only included for members who can create or update events
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID	path		int						true	"id of the org whose events to fetch"
+//	@Success		200		{object}	[]models.SimpleEvent	"org events successfully fetched"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403		{object}	response.DocsErrorResponseForbidden
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/events [get]
+func (h *Handler) getOrganizationEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	organization, _ := ctx.Value(internal.OrgCtx).(*models.Organization)
+
+	includeDrafts, err := canViewDrafts(r, h.store, h.cacheStore)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	events, err := h.store.Events.GetByOrgID(ctx, organization.ID, includeDrafts)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	response.SuccessResponseOK(w, "", map[string]any{"events": events})
+}
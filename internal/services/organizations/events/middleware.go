@@ -0,0 +1,63 @@
+package events
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/middleware"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/store/cache"
+	"github.com/go-chi/chi/v5"
+)
+
+// draftPermissions are the permissions that allow a member to see events
+// that have not been published yet.
+var draftPermissions = []string{internal.EventCreate, internal.EventUpdate}
+
+// canViewDrafts reports whether the user making the request is allowed to
+// see the organization's draft events.
+func canViewDrafts(r *http.Request, appStore store.Store, cacheStore cache.Store) (bool, error) {
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+	organization, _ := ctx.Value(internal.OrgCtx).(*models.Organization)
+
+	return middleware.MemberHasOrgPermission(ctx, r, appStore, cacheStore, draftPermissions, user.UserProfile.ID, organization.ID)
+}
+
+func getEvent(appStore store.Store) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			eventID, err := strconv.ParseInt(chi.URLParam(r, "eventID"), 10, 64)
+			if err != nil {
+				errorMessage := response.ErrorResponse{Message: "Invalid event ID"}
+				response.ErrorResponseBadRequest(w, r, err, errorMessage)
+				return
+			}
+
+			ctx := r.Context()
+			organization, _ := ctx.Value(internal.OrgCtx).(*models.Organization)
+
+			fields := []string{"id", "org_id"}
+			values := []any{eventID, organization.ID}
+			event, err := appStore.Events.Get(ctx, false, fields, values)
+			if err != nil {
+				switch err {
+				case store.ErrNotFound:
+					response.ErrorResponseForbidden(w, r, err)
+				default:
+					response.ErrorResponseInternalServerErr(w, r, err)
+				}
+				return
+			}
+
+			ctx = context.WithValue(ctx, internal.EventCtx, event)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
@@ -0,0 +1,407 @@
+package events
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/rrule"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/utils"
+	"github.com/KengoWada/meetup-clone/internal/validate"
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	// defaultOccurrencesWindow is how far ahead occurrences are listed when
+	// no end of the window is requested.
+	defaultOccurrencesWindow = time.Hour * 24 * 30
+	// maxOccurrencesWindow is the longest window occurrences can be listed for.
+	maxOccurrencesWindow = time.Hour * 24 * 366
+
+	occurrenceScopeThis      = "this"
+	occurrenceScopeFollowing = "following"
+)
+
+type updateOccurrencePayload struct {
+	Title       utils.TrimString `json:"title" validate:"required,max=255"`
+	Description utils.TrimString `json:"description" validate:"required"`
+	StartTime   string           `json:"startTime" validate:"required,is_datetime" example:"2025-03-01T18:00:00Z"`
+	EndTime     string           `json:"endTime" validate:"required,is_datetime" example:"2025-03-01T20:00:00Z"`
+	Location    utils.TrimString `json:"location" validate:"required_without=OnlineURL,max=255"`
+	OnlineURL   utils.TrimString `json:"onlineUrl" validate:"omitempty,http_url"`
+	Capacity    int              `json:"capacity" validate:"required,min=1"`
+}
+
+// GetEventOccurrences godoc
+//
+//	@Summary		Get the occurrences of a recurring event
+//	@Description	Get the occurrences of a recurring event that start within a window, with any edits made to single occurrences applied
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID	path		int							true	"orgID the event belongs to"
+//	@Param			eventID	path		int							true	"eventID whose occurrences to fetch"
+//	@Param			from	query		string						false	"start of the window, defaults to now"				example(2025-03-01T00:00:00Z)
+//	@Param			to		query		string						false	"end of the window, defaults to 30 days after from"	example(2025-04-01T00:00:00Z)
+//	@Success		200		{object}	[]models.EventOccurrence	"occurrences successfully fetched"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403		{object}	response.DocsErrorResponseForbidden
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/events/{eventID}/occurrences [get]
+func (h *Handler) getEventOccurrences(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	event, _ := ctx.Value(internal.EventCtx).(*models.Event)
+
+	if !event.IsPublished() {
+		ok, err := canViewDrafts(r, h.store, h.cacheStore)
+		if err != nil {
+			response.ErrorResponseInternalServerErr(w, r, err)
+			return
+		}
+
+		if !ok {
+			err := errors.New("user does not have permissions to view draft event")
+			response.ErrorResponseForbidden(w, r, err)
+			return
+		}
+	}
+
+	if !event.IsRecurring() {
+		err := errors.New("event does not recur")
+		errorMessage := response.ErrorResponse{Message: "Event does not recur"}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	from, to, err := parseOccurrencesWindow(r)
+	if err != nil {
+		errorMessage := response.ErrorResponse{Message: err.Error()}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	overrides, err := h.store.EventOccurrenceOverrides.GetByEventID(ctx, event.ID)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	occurrences := expandOccurrences(event, overrides, from, to)
+	response.SuccessResponseOK(w, "", map[string]any{"occurrences": occurrences})
+}
+
+// UpdateEventOccurrence godoc
+//
+//	@Summary		Update occurrences of a recurring event
+//	@Description	Update a single occurrence of a recurring event (scope=this), or the occurrence and every one after it (scope=following). Updating the following occurrences ends the series and continues it as a new event
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID		path		int						true	"orgID the event belongs to"
+//	@Param			eventID		path		int						true	"eventID whose occurrence to update"
+//	@Param			occurrence	path		string					true	"start time of the occurrence to update"	example(2025-03-04T18:00:00Z)
+//	@Param			scope		query		string					false	"this or following, defaults to this"
+//	@Param			payload		body		updateOccurrencePayload	true	"update occurrence payload"
+//	@Success		200			{object}	models.EventOccurrence	"occurrence successfully updated"
+//	@Failure		400			{object}	response.DocsErrorResponse
+//	@Failure		401			{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403			{object}	response.DocsErrorResponseForbidden
+//	@Failure		500			{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/events/{eventID}/occurrences/{occurrence} [put]
+func (h *Handler) updateEventOccurrence(w http.ResponseWriter, r *http.Request) {
+	var payload updateOccurrencePayload
+	if err := utils.ReadJSON(w, r, &payload); err != nil {
+		response.ErrorResponseInvalidJSON(w, r, err)
+		return
+	}
+
+	if errorMessages, err := validate.ValidatePayload(payload, occurrencePayloadErrors); err != nil {
+		switch err {
+		case validate.ErrFailedValidation:
+			errorResponse := response.NewValidationErrorResponse(errorMessages)
+			response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	if errorMessages := validateEventTimes(payload.StartTime, payload.EndTime); errorMessages != nil {
+		err := errors.New("event end time is before start time")
+		errorResponse := response.NewValidationErrorResponse(errorMessages)
+		response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		return
+	}
+
+	scope := r.URL.Query().Get("scope")
+	if scope == "" {
+		scope = occurrenceScopeThis
+	}
+
+	if scope != occurrenceScopeThis && scope != occurrenceScopeFollowing {
+		err := errors.New("invalid occurrence scope")
+		errorMessage := response.ErrorResponse{Message: "Scope should be one of this or following"}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	ctx := r.Context()
+	event, _ := ctx.Value(internal.EventCtx).(*models.Event)
+
+	if event.IsCancelled() {
+		err := errors.New("cancelled event can not be updated")
+		errorMessage := response.ErrorResponse{Message: "Event has been cancelled"}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	occurrenceStart, err := parseOccurrence(event, chi.URLParam(r, "occurrence"))
+	if err != nil {
+		errorMessage := response.ErrorResponse{Message: err.Error()}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	if scope == occurrenceScopeFollowing {
+		h.updateFollowingOccurrences(w, r, event, *occurrenceStart, payload)
+		return
+	}
+
+	override := &models.EventOccurrenceOverride{
+		EventID:         event.ID,
+		OccurrenceStart: *occurrenceStart,
+		Title:           string(payload.Title),
+		Description:     string(payload.Description),
+		StartTime:       payload.StartTime,
+		EndTime:         payload.EndTime,
+		Location:        string(payload.Location),
+		OnlineURL:       string(payload.OnlineURL),
+		Capacity:        payload.Capacity,
+	}
+
+	if err := h.store.EventOccurrenceOverrides.Save(ctx, override); err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	response.SuccessResponseOK(w, "Done", newOverriddenOccurrence(override))
+}
+
+// updateFollowingOccurrences ends the event's series before the occurrence
+// and continues it from the occurrence as a new event with the payload's
+// details.
+func (h *Handler) updateFollowingOccurrences(w http.ResponseWriter, r *http.Request, event *models.Event, occurrenceStart string, payload updateOccurrencePayload) {
+	ctx := r.Context()
+	rule, _ := rrule.Parse(event.RRule)
+	dtstart, _ := time.Parse(internal.DateTimeFormat, event.StartTime)
+	splitAt, _ := time.Parse(internal.DateTimeFormat, occurrenceStart)
+	newStart, _ := time.Parse(internal.DateTimeFormat, payload.StartTime)
+
+	if splitAt.Equal(dtstart) {
+		err := errors.New("user tried to split series at its first occurrence")
+		errorMessage := response.ErrorResponse{Message: "Update the event to change every occurrence"}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	// The new series carries on with whatever is left of the original
+	// series' occurrences.
+	newRule := *rule
+	if rule.Count > 0 {
+		newRule.Count = rule.Count - len(rule.Between(dtstart, dtstart, splitAt.Add(-time.Second)))
+	}
+
+	until := splitAt.Add(-time.Second)
+	rule.Count = 0
+	rule.Until = &until
+
+	shift := newStart.Sub(splitAt)
+	var exDates []string
+	for _, exDate := range event.ExDates {
+		excluded, _ := time.Parse(internal.DateTimeFormat, exDate)
+		if !excluded.Before(splitAt) {
+			exDates = append(exDates, excluded.Add(shift).UTC().Format(internal.DateTimeFormat))
+		}
+	}
+
+	newEvent := &models.Event{
+		OrganizationID: event.OrganizationID,
+		Title:          string(payload.Title),
+		Description:    string(payload.Description),
+		StartTime:      payload.StartTime,
+		EndTime:        payload.EndTime,
+		Location:       string(payload.Location),
+		OnlineURL:      string(payload.OnlineURL),
+		VenueID:        event.VenueID,
+		Capacity:       payload.Capacity,
+		PublishedAt:    event.PublishedAt,
+		PublishedBy:    event.PublishedBy,
+		RRule:          newRule.String(),
+		ExDates:        exDates,
+		Topics:         event.Topics,
+	}
+	event.RRule = rule.String()
+
+	if err := h.store.Events.SplitSeries(ctx, event, newEvent, occurrenceStart); err != nil {
+		switch err {
+		case store.ErrNotFound:
+			res := response.ErrorResponse{Message: "Try again later"}
+			response.ErrorResponseBadRequest(w, r, err, res)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	response.SuccessResponseOK(w, "Done", newSimpleEvent(newEvent))
+}
+
+// parseOccurrence checks that value is the start time of one of the
+// recurring event's occurrences and returns it in UTC. Events that do not
+// recur have no occurrences so an empty value is expected for them.
+func parseOccurrence(event *models.Event, value string) (*string, error) {
+	if !event.IsRecurring() {
+		if value != "" {
+			return nil, errors.New("Event does not recur")
+		}
+		return nil, nil
+	}
+
+	if value == "" {
+		return nil, errors.New("Occurrence is required for recurring events")
+	}
+
+	occurrence, err := time.Parse(internal.DateTimeFormat, value)
+	if err != nil {
+		return nil, errors.New("Invalid occurrence")
+	}
+
+	rule, _ := rrule.Parse(event.RRule)
+	dtstart, _ := time.Parse(internal.DateTimeFormat, event.StartTime)
+	if !rule.IsOccurrence(dtstart, occurrence) || isExcluded(event, occurrence) {
+		return nil, errors.New("Invalid occurrence")
+	}
+
+	occurrenceStart := occurrence.UTC().Format(internal.DateTimeFormat)
+	return &occurrenceStart, nil
+}
+
+func parseOccurrencesWindow(r *http.Request) (time.Time, time.Time, error) {
+	from := time.Now().UTC().Truncate(time.Second)
+	if value := r.URL.Query().Get("from"); value != "" {
+		parsed, err := time.Parse(internal.DateTimeFormat, value)
+		if err != nil {
+			return from, from, errors.New("Invalid from date time. yyyy-mm-ddThh:mm:ssZ")
+		}
+		from = parsed
+	}
+
+	to := from.Add(defaultOccurrencesWindow)
+	if value := r.URL.Query().Get("to"); value != "" {
+		parsed, err := time.Parse(internal.DateTimeFormat, value)
+		if err != nil {
+			return from, to, errors.New("Invalid to date time. yyyy-mm-ddThh:mm:ssZ")
+		}
+		to = parsed
+	}
+
+	if to.Before(from) || to.Sub(from) > maxOccurrencesWindow {
+		return from, to, errors.New("Window should end after it starts and be at most a year long")
+	}
+
+	return from, to, nil
+}
+
+// expandOccurrences returns the recurring event's occurrences that start
+// within from and to, with overridden occurrences replaced.
+func expandOccurrences(event *models.Event, overrides []*models.EventOccurrenceOverride, from, to time.Time) []models.EventOccurrence {
+	rule, _ := rrule.Parse(event.RRule)
+	dtstart, _ := time.Parse(internal.DateTimeFormat, event.StartTime)
+	end, _ := time.Parse(internal.DateTimeFormat, event.EndTime)
+	duration := end.Sub(dtstart)
+
+	overridesByStart := make(map[int64]*models.EventOccurrenceOverride)
+	for _, override := range overrides {
+		occurrenceStart, _ := time.Parse(internal.DateTimeFormat, override.OccurrenceStart)
+		overridesByStart[occurrenceStart.Unix()] = override
+	}
+
+	occurrences := make([]models.EventOccurrence, 0)
+	for _, start := range rule.Between(dtstart, from, to) {
+		if isExcluded(event, start) {
+			continue
+		}
+
+		if override, ok := overridesByStart[start.Unix()]; ok {
+			occurrences = append(occurrences, newOverriddenOccurrence(override))
+			continue
+		}
+
+		occurrences = append(occurrences, models.EventOccurrence{
+			EventID:         event.ID,
+			OccurrenceStart: start.UTC().Format(internal.DateTimeFormat),
+			Title:           event.Title,
+			Description:     event.Description,
+			StartTime:       start.UTC().Format(internal.DateTimeFormat),
+			EndTime:         start.Add(duration).UTC().Format(internal.DateTimeFormat),
+			Location:        event.Location,
+			OnlineURL:       event.OnlineURL,
+			Capacity:        event.Capacity,
+		})
+	}
+
+	return occurrences
+}
+
+func newOverriddenOccurrence(override *models.EventOccurrenceOverride) models.EventOccurrence {
+	return models.EventOccurrence{
+		EventID:         override.EventID,
+		OccurrenceStart: override.OccurrenceStart,
+		Title:           override.Title,
+		Description:     override.Description,
+		StartTime:       override.StartTime,
+		EndTime:         override.EndTime,
+		Location:        override.Location,
+		OnlineURL:       override.OnlineURL,
+		Capacity:        override.Capacity,
+		IsOverridden:    true,
+	}
+}
+
+func isExcluded(event *models.Event, occurrence time.Time) bool {
+	return slices.ContainsFunc(event.ExDates, func(exDate string) bool {
+		excluded, err := time.Parse(internal.DateTimeFormat, exDate)
+		return err == nil && excluded.Equal(occurrence)
+	})
+}
+
+// normalizeRRule formats the recurrence rule the same way regardless of how
+// it was written. It expects a rule that passed the `is_rrule` validation.
+func normalizeRRule(value string) string {
+	if value == "" {
+		return ""
+	}
+
+	rule, _ := rrule.Parse(value)
+	return rule.String()
+}
+
+// normalizeDateTimes converts date times to UTC. It expects date times that
+// passed the `is_datetime` validation.
+func normalizeDateTimes(values []string) []string {
+	dateTimes := make([]string, len(values))
+	for index, value := range values {
+		dateTime, _ := time.Parse(internal.DateTimeFormat, value)
+		dateTimes[index] = dateTime.UTC().Format(internal.DateTimeFormat)
+	}
+
+	return dateTimes
+}
@@ -0,0 +1,53 @@
+package events
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+)
+
+// PublishOrganizationEvent godoc
+//
+//	@Summary		Publish an organization event
+//	@Description	Publish a draft organization event so that it is visible to everyone
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID	path		int					true	"orgID the event belongs to"
+//	@Param			eventID	path		int					true	"eventID to publish"
+//	@Success		200		{object}	models.SimpleEvent	"event successfully published"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403		{object}	response.DocsErrorResponseForbidden
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/events/{eventID}/publish [patch]
+func (h *Handler) publishEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+	event, _ := ctx.Value(internal.EventCtx).(*models.Event)
+
+	if event.IsPublished() {
+		err := errors.New("event is already published")
+		errorMessage := response.ErrorResponse{Message: "Event is already published"}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	if err := h.store.Events.Publish(ctx, event, user.UserProfile.ID); err != nil {
+		switch err {
+		case store.ErrNotFound:
+			res := response.ErrorResponse{Message: "Try again later"}
+			response.ErrorResponseBadRequest(w, r, err, res)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	response.SuccessResponseOK(w, "Done", newSimpleEvent(event))
+}
@@ -0,0 +1,113 @@
+package events
+
+import (
+	"net/http"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/config"
+	"github.com/KengoWada/meetup-clone/internal/middleware"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/store/cache"
+	"github.com/go-chi/chi/v5"
+)
+
+var cfg = config.Get()
+
+type Handler struct {
+	store      store.Store
+	cacheStore cache.Store
+}
+
+func NewHandler(store store.Store, cacheStore cache.Store) *Handler {
+	return &Handler{store, cacheStore}
+}
+
+func (h *Handler) RegisterRoutes() http.Handler {
+	mux := chi.NewRouter()
+
+	mux.Get("/", h.getOrganizationEvents)
+	mux.Post(
+		"/",
+		middleware.HasOrgPermission(
+			[]string{internal.EventCreate},
+			h.store,
+			h.cacheStore,
+			h.createEvent,
+		),
+	)
+
+	mux.Route("/{eventID}", func(eventMux chi.Router) {
+		eventMux.Use(getEvent(h.store))
+
+		eventMux.Get("/", h.getEvent)
+		eventMux.Put(
+			"/",
+			middleware.HasOrgPermission(
+				[]string{internal.EventUpdate},
+				h.store,
+				h.cacheStore,
+				h.updateEvent,
+			),
+		)
+		eventMux.Patch(
+			"/publish",
+			middleware.HasOrgPermission(
+				[]string{internal.EventPublish},
+				h.store,
+				h.cacheStore,
+				h.publishEvent,
+			),
+		)
+		eventMux.Patch(
+			"/cancel",
+			middleware.HasOrgPermission(
+				[]string{internal.EventCancel},
+				h.store,
+				h.cacheStore,
+				h.cancelEvent,
+			),
+		)
+		eventMux.Get(
+			"/rsvps",
+			middleware.HasOrgPermission(
+				[]string{internal.EventCreate, internal.EventUpdate},
+				h.store,
+				h.cacheStore,
+				h.getEventRSVPs,
+			),
+		)
+		eventMux.Get("/occurrences", h.getEventOccurrences)
+		eventMux.Put(
+			"/occurrences/{occurrence}",
+			middleware.HasOrgPermission(
+				[]string{internal.EventUpdate},
+				h.store,
+				h.cacheStore,
+				h.updateEventOccurrence,
+			),
+		)
+		eventMux.Get("/rsvp", h.getEventRSVP)
+		eventMux.Put("/rsvp", h.respondToEvent)
+		eventMux.Get("/rsvp/ticket", h.getEventTicket)
+		eventMux.Post(
+			"/check-in",
+			middleware.HasOrgPermission(
+				[]string{internal.EventCheckIn},
+				h.store,
+				h.cacheStore,
+				h.checkInAttendee,
+			),
+		)
+		eventMux.Delete(
+			"/",
+			middleware.HasOrgPermission(
+				[]string{internal.EventDelete},
+				h.store,
+				h.cacheStore,
+				h.deleteEvent,
+			),
+		)
+	})
+
+	return mux
+}
@@ -0,0 +1,198 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/utils"
+	"github.com/KengoWada/meetup-clone/internal/validate"
+)
+
+type rsvpPayload struct {
+	Status string `json:"status" validate:"required,oneof=going not_going maybe" example:"going"`
+}
+
+// RespondToEvent godoc
+//
+//	@Summary		RSVP to an event
+//	@Description	RSVP to a published event. Users who want to go to a full event are added to the waitlist. RSVPs to recurring events are made per occurrence
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID		path		int					true	"orgID the event belongs to"
+//	@Param			eventID		path		int					true	"eventID to RSVP to"
+//	@Param			occurrence	query		string				false	"start time of the occurrence to RSVP to, required for recurring events"
+//	@Param			payload		body		rsvpPayload			true	"rsvp payload"
+//	@Success		200			{object}	models.EventRSVP	"rsvp successfully recorded"
+//	@Failure		400			{object}	response.DocsErrorResponse
+//	@Failure		401			{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403			{object}	response.DocsErrorResponseForbidden
+//	@Failure		500			{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/events/{eventID}/rsvp [put]
+func (h *Handler) respondToEvent(w http.ResponseWriter, r *http.Request) {
+	var payload rsvpPayload
+	if err := utils.ReadJSON(w, r, &payload); err != nil {
+		response.ErrorResponseInvalidJSON(w, r, err)
+		return
+	}
+
+	if errorMessages, err := validate.ValidatePayload(payload, rsvpPayloadErrors); err != nil {
+		switch err {
+		case validate.ErrFailedValidation:
+			errorResponse := response.NewValidationErrorResponse(errorMessages)
+			response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+	event, _ := ctx.Value(internal.EventCtx).(*models.Event)
+
+	if !event.IsPublished() {
+		err := errors.New("user tried to rsvp to a draft event")
+		response.ErrorResponseForbidden(w, r, err)
+		return
+	}
+
+	occurrenceStart, err := parseOccurrence(event, r.URL.Query().Get("occurrence"))
+	if err != nil {
+		errorMessage := response.ErrorResponse{Message: err.Error()}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	if message, ok := validateEventOpenForRSVPs(event, occurrenceStart); !ok {
+		err := errors.New("event is not open for rsvps")
+		errorMessage := response.ErrorResponse{Message: message}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	rsvp := &models.EventRSVP{
+		EventID:         event.ID,
+		UserProfileID:   user.UserProfile.ID,
+		OccurrenceStart: occurrenceStart,
+		Status:          models.RSVPStatus(payload.Status),
+	}
+	ticket, err := generateTicket(rsvp)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+	rsvp.Ticket = &ticket
+
+	notification := &models.Notification{
+		Type:    models.NotificationWaitlistPromoted,
+		Message: fmt.Sprintf("A spot opened up and you are now going to %s", event.Title),
+	}
+
+	if err := h.store.EventRSVPs.Respond(ctx, rsvp, notification); err != nil {
+		switch err {
+		case store.ErrNotFound:
+			res := response.ErrorResponse{Message: "Try again later"}
+			response.ErrorResponseBadRequest(w, r, err, res)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	response.SuccessResponseOK(w, "Done", rsvp)
+}
+
+// GetEventRSVP godoc
+//
+//	@Summary		Get your RSVP to an event
+//	@Description	Get your RSVP to an event, including your place on the waitlist
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID		path		int					true	"orgID the event belongs to"
+//	@Param			eventID		path		int					true	"eventID whose RSVP to fetch"
+//	@Param			occurrence	query		string				false	"start time of the occurrence, required for recurring events"
+//	@Success		200			{object}	models.EventRSVP	"rsvp successfully fetched"
+//	@Failure		400			{object}	response.DocsErrorResponse
+//	@Failure		401			{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403			{object}	response.DocsErrorResponseForbidden
+//	@Failure		500			{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/events/{eventID}/rsvp [get]
+func (h *Handler) getEventRSVP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+	event, _ := ctx.Value(internal.EventCtx).(*models.Event)
+
+	occurrenceStart, err := parseOccurrence(event, r.URL.Query().Get("occurrence"))
+	if err != nil {
+		errorMessage := response.ErrorResponse{Message: err.Error()}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	fields := []string{"event_id", "user_id"}
+	values := []any{event.ID, user.UserProfile.ID}
+	if occurrenceStart != nil {
+		fields = append(fields, "occurrence_start")
+		values = append(values, *occurrenceStart)
+	}
+
+	rsvp, err := h.store.EventRSVPs.Get(ctx, false, fields, values)
+	if err != nil {
+		switch err {
+		case store.ErrNotFound:
+			errorMessage := response.ErrorResponse{Message: "You have not responded to this event"}
+			response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	response.SuccessResponseOK(w, "", rsvp)
+}
+
+// GetEventRSVPs godoc
+//
+//	@Summary		Get an event's RSVPs
+//	@Description	Get everyone who responded to an event, with the waitlist in order
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID		path		int						true	"orgID the event belongs to"
+//	@Param			eventID		path		int						true	"eventID whose RSVPs to fetch"
+//	@Param			occurrence	query		string					false	"start time of the occurrence, required for recurring events"
+//	@Success		200			{object}	[]models.EventAttendee	"rsvps successfully fetched"
+//	@Failure		400			{object}	response.DocsErrorResponse
+//	@Failure		401			{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403			{object}	response.DocsErrorResponseForbidden
+//	@Failure		500			{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/events/{eventID}/rsvps [get]
+func (h *Handler) getEventRSVPs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	event, _ := ctx.Value(internal.EventCtx).(*models.Event)
+
+	occurrenceStart, err := parseOccurrence(event, r.URL.Query().Get("occurrence"))
+	if err != nil {
+		errorMessage := response.ErrorResponse{Message: err.Error()}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	attendees, err := h.store.EventRSVPs.GetByEventID(ctx, event.ID, occurrenceStart)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	response.SuccessResponseOK(w, "", map[string]any{"rsvps": attendees})
+}
@@ -0,0 +1,245 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCancelEvent(t *testing.T) {
+	testEndpoint := func(orgID, eventID int64) string {
+		return fmt.Sprintf("/v1/organizations/%d/events/%d/cancel", orgID, eventID)
+	}
+	testMethod := http.MethodPatch
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func(isActive bool, role *models.Role, userID int64) *models.Organization {
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, isActive, role, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org
+	}
+
+	createTestEvent := func(isDeleted bool, orgID int64) *models.Event {
+		event, err := testutils.CreateTestEvent(ctx, appItems.App.Store, isDeleted, orgID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return event
+	}
+
+	createTestPublishedEvent := func(orgID, userProfileID int64) *models.Event {
+		event, err := testutils.CreateTestPublishedEvent(ctx, appItems.App.Store, false, orgID, userProfileID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return event
+	}
+
+	createTestRSVP := func(eventID int64, status models.RSVPStatus) *models.User {
+		user := createTestUser(true)
+		if _, err := testutils.CreateTestRSVP(ctx, appItems.App.Store, eventID, user.UserProfile.ID, status); err != nil {
+			t.Fatal(err)
+		}
+
+		return user
+	}
+
+	generateRole := func(permission string) *models.Role {
+		role := &models.Role{Name: faker.Username(options.WithGenerateUniqueValues(true))}
+
+		switch permission {
+		case "valid":
+			role.Permissions = []string{internal.EventCancel}
+		case "invalid":
+			role.Permissions = []string{internal.EventCreate, internal.EventUpdate}
+		default:
+			role.Permissions = internal.Permissions
+		}
+
+		return role
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	t.Run("should cancel event and notify attendees", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testEvent := createTestPublishedEvent(testOrg.ID, testUser.UserProfile.ID)
+		goingUser := createTestRSVP(testEvent.ID, models.RSVPGoing)
+		maybeUser := createTestRSVP(testEvent.ID, models.RSVPMaybe)
+		notGoingUser := createTestRSVP(testEvent.ID, models.RSVPNotGoing)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		payload := testutils.TestRequestData{"reason": "The venue is flooded"}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testEvent.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "Done", response.GetMessage())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+		assert.NotNil(t, data["cancelledAt"])
+		assert.Equal(t, payload["reason"], data["cancellationReason"])
+
+		for _, user := range []*models.User{goingUser, maybeUser} {
+			notifications, err := appItems.App.Store.Notifications.GetByUserID(ctx, user.UserProfile.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Len(t, notifications, 1)
+			assert.Equal(t, models.NotificationEventCancelled, notifications[0].Type)
+			assert.Equal(t, testEvent.ID, *notifications[0].EventID)
+		}
+
+		notifications, err := appItems.App.Store.Notifications.GetByUserID(ctx, notGoingUser.UserProfile.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Len(t, notifications, 0)
+	})
+
+	t.Run("should get cancelled event", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testEvent := createTestPublishedEvent(testOrg.ID, testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		payload := testutils.TestRequestData{"reason": "The speaker is unwell"}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testEvent.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		endpoint := fmt.Sprintf("/v1/organizations/%d/events/%d", testOrg.ID, testEvent.ID)
+		response, err = testutils.RunTestRequest(mux, http.MethodGet, endpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+		assert.NotNil(t, data["cancelledAt"])
+		assert.Equal(t, payload["reason"], data["cancellationReason"])
+	})
+
+	t.Run("should not cancel event twice", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testEvent := createTestPublishedEvent(testOrg.ID, testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		payload := testutils.TestRequestData{"reason": "The venue is flooded"}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testEvent.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		response, err = testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testEvent.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Event is already cancelled", response.GetMessage())
+	})
+
+	t.Run("should not cancel draft event", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testEvent := createTestEvent(false, testOrg.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		payload := testutils.TestRequestData{"reason": "The venue is flooded"}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testEvent.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Draft events can not be cancelled", response.GetMessage())
+	})
+
+	t.Run("should not cancel event without reason", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testEvent := createTestPublishedEvent(testOrg.ID, testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		payload := testutils.TestRequestData{"reason": "   "}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testEvent.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invalid request body", response.GetMessage())
+
+		errorMessages, ok := response.GetErrorMessages()
+		if !ok {
+			t.Fatal("failed to convert response errors to map")
+		}
+
+		assert.Equal(t, "Field is required", errorMessages["reason"])
+	})
+
+	t.Run("should not cancel event with invalid permissions", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("invalid"), testUser.UserProfile.ID)
+		testEvent := createTestPublishedEvent(testOrg.ID, testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		payload := testutils.TestRequestData{"reason": "The venue is flooded"}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testEvent.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+}
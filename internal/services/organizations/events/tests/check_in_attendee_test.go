@@ -0,0 +1,188 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckInAttendee(t *testing.T) {
+	testEndpoint := func(orgID, eventID int64) string {
+		return fmt.Sprintf("/v1/organizations/%d/events/%d/check-in", orgID, eventID)
+	}
+	testMethod := http.MethodPost
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func(role *models.Role, userID int64) *models.Organization {
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, true, role, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org
+	}
+
+	createTestEvent := func(orgID, userProfileID int64) *models.Event {
+		event, err := testutils.CreateTestPublishedEvent(ctx, appItems.App.Store, false, orgID, userProfileID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return event
+	}
+
+	generateRole := func(permission string) *models.Role {
+		role := &models.Role{Name: faker.Username(options.WithGenerateUniqueValues(true))}
+
+		switch permission {
+		case "valid":
+			role.Permissions = []string{internal.EventCheckIn}
+		case "invalid":
+			role.Permissions = []string{internal.EventUpdate}
+		default:
+			role.Permissions = internal.Permissions
+		}
+
+		return role
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	// respond RSVPs to the event and returns the user's ticket.
+	respond := func(user *models.User, orgID, eventID int64, status models.RSVPStatus) string {
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(user.ID, true)}
+		endpoint := fmt.Sprintf("/v1/organizations/%d/events/%d/rsvp", orgID, eventID)
+		payload := testutils.TestRequestData{"status": status}
+		response, err := testutils.RunTestRequest(mux, http.MethodPut, endpoint, headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		return data["ticket"].(string)
+	}
+
+	checkIn := func(member *models.User, orgID, eventID int64, ticket string) *testutils.TestRequestResponse {
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(member.ID, true)}
+		payload := testutils.TestRequestData{"ticket": ticket}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(orgID, eventID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return response
+	}
+
+	t.Run("should check in attendee", func(t *testing.T) {
+		testMember := createTestUser(true)
+		testOrg := createTestOrg(generateRole("valid"), testMember.UserProfile.ID)
+		testEvent := createTestEvent(testOrg.ID, testMember.UserProfile.ID)
+		ticket := respond(createTestUser(true), testOrg.ID, testEvent.ID, models.RSVPGoing)
+
+		response := checkIn(testMember, testOrg.ID, testEvent.ID, ticket)
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "Done", response.GetMessage())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+		assert.NotNil(t, data["checkedInAt"])
+		assert.EqualValues(t, testMember.UserProfile.ID, data["checkedInBy"])
+		checkedInAt := data["checkedInAt"]
+
+		response = checkIn(testMember, testOrg.ID, testEvent.ID, ticket)
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		data, ok = response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+		assert.Equal(t, checkedInAt, data["checkedInAt"])
+	})
+
+	t.Run("should not check in attendee who is not going", func(t *testing.T) {
+		testMember := createTestUser(true)
+		testOrg := createTestOrg(generateRole("valid"), testMember.UserProfile.ID)
+		testEvent := createTestEvent(testOrg.ID, testMember.UserProfile.ID)
+		testUser := createTestUser(true)
+		ticket := respond(testUser, testOrg.ID, testEvent.ID, models.RSVPGoing)
+		respond(testUser, testOrg.ID, testEvent.ID, models.RSVPNotGoing)
+
+		response := checkIn(testMember, testOrg.ID, testEvent.ID, ticket)
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Attendee is not going to this event", response.GetMessage())
+	})
+
+	t.Run("should not check in ticket for another event", func(t *testing.T) {
+		testMember := createTestUser(true)
+		testOrg := createTestOrg(generateRole("valid"), testMember.UserProfile.ID)
+		testEvent := createTestEvent(testOrg.ID, testMember.UserProfile.ID)
+		testEventTwo := createTestEvent(testOrg.ID, testMember.UserProfile.ID)
+		ticket := respond(createTestUser(true), testOrg.ID, testEventTwo.ID, models.RSVPGoing)
+
+		response := checkIn(testMember, testOrg.ID, testEvent.ID, ticket)
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Ticket is for another event", response.GetMessage())
+	})
+
+	t.Run("should not check in invalid ticket", func(t *testing.T) {
+		testMember := createTestUser(true)
+		testOrg := createTestOrg(generateRole("valid"), testMember.UserProfile.ID)
+		testEvent := createTestEvent(testOrg.ID, testMember.UserProfile.ID)
+
+		response := checkIn(testMember, testOrg.ID, testEvent.ID, "not-a-ticket")
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invalid ticket", response.GetMessage())
+	})
+
+	t.Run("should not check in attendee with invalid permissions", func(t *testing.T) {
+		testMember := createTestUser(true)
+		testOrg := createTestOrg(generateRole("invalid"), testMember.UserProfile.ID)
+		testEvent := createTestEvent(testOrg.ID, testMember.UserProfile.ID)
+		ticket := respond(createTestUser(true), testOrg.ID, testEvent.ID, models.RSVPGoing)
+
+		response := checkIn(testMember, testOrg.ID, testEvent.ID, ticket)
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+}
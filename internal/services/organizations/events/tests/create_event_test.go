@@ -0,0 +1,341 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateEvent(t *testing.T) {
+	testEndpoint := func(orgID int64) string {
+		return fmt.Sprintf("/v1/organizations/%d/events", orgID)
+	}
+	testMethod := http.MethodPost
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func(isActive bool, role *models.Role, userID int64) *models.Organization {
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, isActive, role, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org
+	}
+
+	generateRole := func(permission string) *models.Role {
+		role := &models.Role{Name: faker.Username(options.WithGenerateUniqueValues(true))}
+
+		switch permission {
+		case "valid":
+			role.Permissions = []string{internal.EventCreate}
+		case "invalid":
+			role.Permissions = []string{internal.EventUpdate, internal.EventDelete}
+		default:
+			role.Permissions = internal.Permissions
+		}
+
+		return role
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	generatePayload := func() testutils.TestRequestData {
+		title := faker.Username(options.WithGenerateUniqueValues(true))
+		startTime, endTime := testutils.GenerateEventTimes(time.Hour * 48)
+
+		return testutils.TestRequestData{
+			"title":       title,
+			"description": title + " description",
+			"startTime":   startTime,
+			"endTime":     endTime,
+			"location":    "Kampala, Uganda",
+			"onlineUrl":   "https://meet.fake.link/event",
+			"capacity":    25,
+		}
+	}
+
+	t.Run("should create event", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		payload := generatePayload()
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusCreated, response.StatusCode())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		assert.Equal(t, payload["title"], data["title"])
+		assert.Equal(t, payload["description"], data["description"])
+		assert.Equal(t, payload["location"], data["location"])
+		assert.Equal(t, payload["onlineUrl"], data["onlineUrl"])
+		assert.EqualValues(t, payload["capacity"], data["capacity"])
+	})
+
+	t.Run("should not create event with invalid permissions", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("invalid"), testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID), headers, generatePayload())
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+
+	t.Run("should not create event when not group member", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testUserTwo := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUserTwo.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID), headers, generatePayload())
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+
+	t.Run("should not create event without location or online url", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		payload := generatePayload()
+		delete(payload, "location")
+		delete(payload, "onlineUrl")
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invalid request body", response.GetMessage())
+
+		errorMessages, ok := response.GetErrorMessages()
+		if !ok {
+			t.Fatal("failed to convert response errors to map")
+		}
+
+		assert.Equal(t, "Either location or online URL is required", errorMessages["location"])
+	})
+
+	t.Run("should not create event that ends before it starts", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		payload := generatePayload()
+		payload["startTime"], payload["endTime"] = payload["endTime"], payload["startTime"]
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invalid request body", response.GetMessage())
+
+		errorMessages, ok := response.GetErrorMessages()
+		if !ok {
+			t.Fatal("failed to convert response errors to map")
+		}
+
+		assert.Equal(t, "End time must be after start time", errorMessages["endTime"])
+	})
+
+	t.Run("should create recurring event", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		payload := generatePayload()
+		payload["rrule"] = "RRULE:FREQ=WEEKLY;INTERVAL=1;BYDAY=TU,TH;COUNT=10"
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusCreated, response.StatusCode())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		assert.Equal(t, "FREQ=WEEKLY;BYDAY=TU,TH;COUNT=10", data["rrule"])
+	})
+
+	t.Run("should not create event with invalid recurrence rule", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		payload := generatePayload()
+		payload["rrule"] = "FREQ=HOURLY;COUNT=5"
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+
+		errorMessages, ok := response.GetErrorMessages()
+		if !ok {
+			t.Fatal("failed to convert response errors to map")
+		}
+
+		assert.Equal(t, "Invalid recurrence rule. Supported parts are FREQ, INTERVAL, BYDAY, COUNT and UNTIL", errorMessages["rrule"])
+	})
+
+	t.Run("should create event with topics", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		payload := generatePayload()
+		payload["topics"] = []string{" Golang ", "golang", "Cloud Native"}
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusCreated, response.StatusCode())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		assert.Equal(t, []any{"golang", "cloud native"}, data["topics"])
+	})
+
+	t.Run("should create event at venue", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+
+		latitude, longitude := testutils.GenerateCoordinates()
+		venue, err := testutils.CreateTestVenue(ctx, appItems.App.Store, false, testOrg.ID, latitude, longitude)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		payload := generatePayload()
+		payload["venueId"] = venue.ID
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusCreated, response.StatusCode())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		assert.EqualValues(t, venue.ID, data["venueId"])
+	})
+
+	t.Run("should not create event at another organization's venue", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testOrgTwo := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+
+		latitude, longitude := testutils.GenerateCoordinates()
+		venue, err := testutils.CreateTestVenue(ctx, appItems.App.Store, false, testOrgTwo.ID, latitude, longitude)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		payload := generatePayload()
+		payload["venueId"] = venue.ID
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+
+		errorMessages, ok := response.GetErrorMessages()
+		if !ok {
+			t.Fatal("failed to convert response errors to map")
+		}
+
+		assert.Equal(t, "Invalid venue", errorMessages["venueId"])
+	})
+
+	t.Run("should not create event with invalid data", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		payload := generatePayload()
+		payload["startTime"] = "03/01/2025"
+		payload["onlineUrl"] = "not-a-link"
+		payload["capacity"] = 0
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invalid request body", response.GetMessage())
+
+		errorMessages, ok := response.GetErrorMessages()
+		if !ok {
+			t.Fatal("failed to convert response errors to map")
+		}
+
+		assert.Equal(t, "Invalid date time format. yyyy-mm-ddThh:mm:ssZ", errorMessages["startTime"])
+		assert.Equal(t, "Invalid URL format", errorMessages["onlineUrl"])
+		assert.Equal(t, "Field is required", errorMessages["capacity"])
+	})
+}
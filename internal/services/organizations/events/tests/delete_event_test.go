@@ -0,0 +1,120 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteEvent(t *testing.T) {
+	testEndpoint := func(orgID, eventID int64) string {
+		return fmt.Sprintf("/v1/organizations/%d/events/%d", orgID, eventID)
+	}
+	testMethod := http.MethodDelete
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func(isActive bool, role *models.Role, userID int64) *models.Organization {
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, isActive, role, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org
+	}
+
+	createTestEvent := func(isDeleted bool, orgID int64) *models.Event {
+		event, err := testutils.CreateTestEvent(ctx, appItems.App.Store, isDeleted, orgID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return event
+	}
+
+	generateRole := func(permission string) *models.Role {
+		role := &models.Role{Name: faker.Username(options.WithGenerateUniqueValues(true))}
+
+		switch permission {
+		case "valid":
+			role.Permissions = []string{internal.EventDelete}
+		case "invalid":
+			role.Permissions = []string{internal.EventCreate, internal.EventUpdate}
+		default:
+			role.Permissions = internal.Permissions
+		}
+
+		return role
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	t.Run("should delete event", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testEvent := createTestEvent(false, testOrg.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testEvent.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "Done", response.GetMessage())
+
+		fields, values := []string{"id"}, []any{testEvent.ID}
+		deletedEvent, err := appItems.App.Store.Events.Get(ctx, true, fields, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.NotNil(t, deletedEvent.DeletedAt)
+	})
+
+	t.Run("should not delete event with invalid permissions", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("invalid"), testUser.UserProfile.ID)
+		testEvent := createTestEvent(false, testOrg.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testEvent.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+}
@@ -0,0 +1,208 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEventOccurrences(t *testing.T) {
+	testEndpoint := func(orgID, eventID int64, query string) string {
+		return fmt.Sprintf("/v1/organizations/%d/events/%d/occurrences%s", orgID, eventID, query)
+	}
+	testMethod := http.MethodGet
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func(userID int64) *models.Organization {
+		role := &models.Role{
+			Name:        faker.Username(options.WithGenerateUniqueValues(true)),
+			Permissions: []string{internal.EventUpdate},
+		}
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, true, role, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org
+	}
+
+	createTestEvent := func(orgID, userProfileID int64, rrule string) *models.Event {
+		event, err := testutils.CreateTestRecurringEvent(ctx, appItems.App.Store, orgID, userProfileID, rrule)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return event
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	t.Run("should get event occurrences", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(testUser.UserProfile.ID)
+		testEvent := createTestEvent(testOrg.ID, testUser.UserProfile.ID, "FREQ=WEEKLY;COUNT=3")
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(createTestUser(true).ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testEvent.ID, ""), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		occurrences, ok := data["occurrences"].([]any)
+		if !ok {
+			t.Fatal("failed to convert occurrences to list")
+		}
+
+		startTime, _ := time.Parse(internal.DateTimeFormat, testEvent.StartTime)
+		assert.Len(t, occurrences, 3)
+		for index, item := range occurrences {
+			occurrence := item.(map[string]any)
+			expected := startTime.AddDate(0, 0, 7*index).Format(internal.DateTimeFormat)
+			assert.Equal(t, expected, occurrence["occurrenceStart"])
+			assert.Equal(t, testEvent.Title, occurrence["title"])
+			assert.Equal(t, false, occurrence["isOverridden"])
+		}
+	})
+
+	t.Run("should get event occurrences within window", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(testUser.UserProfile.ID)
+		testEvent := createTestEvent(testOrg.ID, testUser.UserProfile.ID, "FREQ=DAILY")
+
+		startTime, _ := time.Parse(internal.DateTimeFormat, testEvent.StartTime)
+		from := startTime.AddDate(0, 0, 2).Format(internal.DateTimeFormat)
+		to := startTime.AddDate(0, 0, 4).Format(internal.DateTimeFormat)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		endpoint := testEndpoint(testOrg.ID, testEvent.ID, fmt.Sprintf("?from=%s&to=%s", from, to))
+		response, err := testutils.RunTestRequest(mux, testMethod, endpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		occurrences, ok := data["occurrences"].([]any)
+		if !ok {
+			t.Fatal("failed to convert occurrences to list")
+		}
+
+		assert.Len(t, occurrences, 3)
+		assert.Equal(t, from, occurrences[0].(map[string]any)["occurrenceStart"])
+		assert.Equal(t, to, occurrences[2].(map[string]any)["occurrenceStart"])
+	})
+
+	t.Run("should skip excluded occurrences", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(testUser.UserProfile.ID)
+		testEvent := createTestEvent(testOrg.ID, testUser.UserProfile.ID, "FREQ=WEEKLY;COUNT=4")
+
+		startTime, _ := time.Parse(internal.DateTimeFormat, testEvent.StartTime)
+		testEvent.ExDates = []string{startTime.AddDate(0, 0, 7).Format(internal.DateTimeFormat)}
+		if err := appItems.App.Store.Events.Update(ctx, testEvent); err != nil {
+			t.Fatal(err)
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testEvent.ID, ""), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		occurrences, ok := data["occurrences"].([]any)
+		if !ok {
+			t.Fatal("failed to convert occurrences to list")
+		}
+
+		// The excluded occurrence still counts towards COUNT.
+		assert.Len(t, occurrences, 3)
+		for index, days := range []int{0, 14, 21} {
+			expected := startTime.AddDate(0, 0, days).Format(internal.DateTimeFormat)
+			assert.Equal(t, expected, occurrences[index].(map[string]any)["occurrenceStart"])
+		}
+	})
+
+	t.Run("should not get occurrences of event that does not recur", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(testUser.UserProfile.ID)
+		testEvent, err := testutils.CreateTestPublishedEvent(ctx, appItems.App.Store, false, testOrg.ID, testUser.UserProfile.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testEvent.ID, ""), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Event does not recur", response.GetMessage())
+	})
+
+	t.Run("should not get event occurrences with invalid window", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(testUser.UserProfile.ID)
+		testEvent := createTestEvent(testOrg.ID, testUser.UserProfile.ID, "FREQ=DAILY")
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		endpoint := testEndpoint(testOrg.ID, testEvent.ID, "?from=2025-01-01T00:00:00Z&to=2027-01-01T00:00:00Z")
+		response, err := testutils.RunTestRequest(mux, testMethod, endpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Window should end after it starts and be at most a year long", response.GetMessage())
+	})
+}
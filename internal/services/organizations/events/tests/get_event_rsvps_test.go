@@ -0,0 +1,129 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEventRSVPs(t *testing.T) {
+	testEndpoint := func(orgID, eventID int64) string {
+		return fmt.Sprintf("/v1/organizations/%d/events/%d/rsvps", orgID, eventID)
+	}
+	testMethod := http.MethodGet
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func(isActive bool, role *models.Role, userID int64) *models.Organization {
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, isActive, role, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org
+	}
+
+	createTestEvent := func(isDeleted bool, orgID int64) *models.Event {
+		event, err := testutils.CreateTestEvent(ctx, appItems.App.Store, isDeleted, orgID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return event
+	}
+
+	generateRole := func(permission string) *models.Role {
+		role := &models.Role{Name: faker.Username(options.WithGenerateUniqueValues(true))}
+
+		switch permission {
+		case "valid":
+			role.Permissions = []string{internal.EventUpdate}
+		case "invalid":
+			role.Permissions = []string{internal.EventPublish, internal.EventCancel}
+		default:
+			role.Permissions = internal.Permissions
+		}
+
+		return role
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	t.Run("should get event rsvps", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testEvent := createTestEvent(false, testOrg.ID)
+		for _, status := range []models.RSVPStatus{models.RSVPGoing, models.RSVPMaybe, models.RSVPNotGoing} {
+			attendee := createTestUser(true)
+			if _, err := testutils.CreateTestRSVP(ctx, appItems.App.Store, testEvent.ID, attendee.UserProfile.ID, status); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testEvent.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		rsvps, ok := data["rsvps"].([]any)
+		if !ok {
+			t.Fatal("failed to convert rsvps to slice")
+		}
+		assert.Len(t, rsvps, 3)
+	})
+
+	t.Run("should not get event rsvps with invalid permissions", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("invalid"), testUser.UserProfile.ID)
+		testEvent := createTestEvent(false, testOrg.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testEvent.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+}
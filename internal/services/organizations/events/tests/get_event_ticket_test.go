@@ -0,0 +1,124 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEventTicket(t *testing.T) {
+	testEndpoint := func(orgID, eventID int64) string {
+		return fmt.Sprintf("/v1/organizations/%d/events/%d/rsvp/ticket", orgID, eventID)
+	}
+	testMethod := http.MethodGet
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestEvent := func() (*models.Organization, *models.Event) {
+		organizer := createTestUser(true)
+		role := &models.Role{
+			Name:        faker.Username(options.WithGenerateUniqueValues(true)),
+			Permissions: []string{internal.EventCreate},
+		}
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, true, role, organizer.UserProfile.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		event, err := testutils.CreateTestPublishedEvent(ctx, appItems.App.Store, false, org.ID, organizer.UserProfile.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org, event
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	respond := func(user *models.User, orgID, eventID int64, status models.RSVPStatus) {
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(user.ID, true)}
+		endpoint := fmt.Sprintf("/v1/organizations/%d/events/%d/rsvp", orgID, eventID)
+		payload := testutils.TestRequestData{"status": status}
+		response, err := testutils.RunTestRequest(mux, http.MethodPut, endpoint, headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+	}
+
+	t.Run("should get event ticket", func(t *testing.T) {
+		testOrg, testEvent := createTestEvent()
+		testUser := createTestUser(true)
+		respond(testUser, testOrg.ID, testEvent.ID, models.RSVPGoing)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testEvent.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "image/png", response.Response.Header().Get("Content-Type"))
+		assert.NotEmpty(t, response.Response.Body.Bytes())
+	})
+
+	t.Run("should not get ticket when not going", func(t *testing.T) {
+		testOrg, testEvent := createTestEvent()
+		testUser := createTestUser(true)
+		respond(testUser, testOrg.ID, testEvent.ID, models.RSVPMaybe)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testEvent.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Only attendees who are going have a ticket", response.GetMessage())
+	})
+
+	t.Run("should not get ticket without rsvp", func(t *testing.T) {
+		testOrg, testEvent := createTestEvent()
+		testUser := createTestUser(true)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testEvent.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Only attendees who are going have a ticket", response.GetMessage())
+	})
+}
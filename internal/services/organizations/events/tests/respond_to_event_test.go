@@ -0,0 +1,269 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRespondToEvent(t *testing.T) {
+	testEndpoint := func(orgID, eventID int64) string {
+		return fmt.Sprintf("/v1/organizations/%d/events/%d/rsvp", orgID, eventID)
+	}
+	testMethod := http.MethodPut
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func() (*models.Organization, *models.User) {
+		organizer := createTestUser(true)
+		role := &models.Role{
+			Name:        faker.Username(options.WithGenerateUniqueValues(true)),
+			Permissions: []string{internal.EventCreate, internal.EventPublish},
+		}
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, true, role, organizer.UserProfile.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org, organizer
+	}
+
+	createTestEvent := func(capacity int, startsIn time.Duration, publish bool) (*models.Organization, *models.Event) {
+		org, organizer := createTestOrg()
+		startTime, endTime := testutils.GenerateEventTimes(startsIn)
+		event := &models.Event{
+			OrganizationID: org.ID,
+			Title:          faker.Username(options.WithGenerateUniqueValues(true)),
+			Description:    "RSVP test event",
+			StartTime:      startTime,
+			EndTime:        endTime,
+			Location:       "Kampala, Uganda",
+			Capacity:       capacity,
+		}
+		if err := appItems.App.Store.Events.Create(ctx, event); err != nil {
+			t.Fatal(err)
+		}
+
+		if publish {
+			if err := appItems.App.Store.Events.Publish(ctx, event, organizer.UserProfile.ID); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		return org, event
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	respond := func(user *models.User, orgID, eventID int64, status models.RSVPStatus) *testutils.TestRequestResponse {
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(user.ID, true)}
+		payload := testutils.TestRequestData{"status": status}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(orgID, eventID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return response
+	}
+
+	t.Run("should rsvp to event", func(t *testing.T) {
+		testOrg, testEvent := createTestEvent(10, time.Hour*24, true)
+		testUser := createTestUser(true)
+
+		response := respond(testUser, testOrg.ID, testEvent.ID, models.RSVPGoing)
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "Done", response.GetMessage())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+		assert.Equal(t, string(models.RSVPGoing), data["status"])
+		assert.Nil(t, data["waitlistPosition"])
+		assert.NotEmpty(t, data["ticket"])
+		ticket := data["ticket"]
+
+		response = respond(testUser, testOrg.ID, testEvent.ID, models.RSVPMaybe)
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		data, ok = response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+		assert.Equal(t, string(models.RSVPMaybe), data["status"])
+		assert.Equal(t, ticket, data["ticket"])
+	})
+
+	t.Run("should waitlist users when event is full", func(t *testing.T) {
+		testOrg, testEvent := createTestEvent(1, time.Hour*24, true)
+		users := []*models.User{createTestUser(true), createTestUser(true), createTestUser(true)}
+
+		response := respond(users[0], testOrg.ID, testEvent.ID, models.RSVPGoing)
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		for index, user := range users[1:] {
+			response := respond(user, testOrg.ID, testEvent.ID, models.RSVPGoing)
+			assert.Equal(t, http.StatusOK, response.StatusCode())
+
+			data, ok := response.GetData()
+			if !ok {
+				t.Fatal("failed to convert response data to map")
+			}
+			assert.Equal(t, string(models.RSVPWaitlisted), data["status"])
+			assert.EqualValues(t, index+1, data["waitlistPosition"])
+		}
+	})
+
+	t.Run("should promote waitlisted user when someone drops out", func(t *testing.T) {
+		testOrg, testEvent := createTestEvent(1, time.Hour*24, true)
+		users := []*models.User{createTestUser(true), createTestUser(true), createTestUser(true)}
+		for _, user := range users {
+			response := respond(user, testOrg.ID, testEvent.ID, models.RSVPGoing)
+			assert.Equal(t, http.StatusOK, response.StatusCode())
+		}
+
+		response := respond(users[0], testOrg.ID, testEvent.ID, models.RSVPNotGoing)
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		fields := []string{"event_id", "user_id"}
+		promoted, err := appItems.App.Store.EventRSVPs.Get(ctx, false, fields, []any{testEvent.ID, users[1].UserProfile.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, models.RSVPGoing, promoted.Status)
+		assert.Nil(t, promoted.WaitlistPosition)
+
+		waitlisted, err := appItems.App.Store.EventRSVPs.Get(ctx, false, fields, []any{testEvent.ID, users[2].UserProfile.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, models.RSVPWaitlisted, waitlisted.Status)
+		assert.Equal(t, 1, *waitlisted.WaitlistPosition)
+
+		notifications, err := appItems.App.Store.Notifications.GetByUserID(ctx, users[1].UserProfile.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Len(t, notifications, 1)
+		assert.Equal(t, models.NotificationWaitlistPromoted, notifications[0].Type)
+	})
+
+	t.Run("should not rsvp to cancelled event", func(t *testing.T) {
+		testOrg, testEvent := createTestEvent(10, time.Hour*24, true)
+		testEvent.CancellationReason = "Cancelled for testing"
+		notification := &models.Notification{Type: models.NotificationEventCancelled, Message: testEvent.CancellationReason}
+		if err := appItems.App.Store.Events.Cancel(ctx, testEvent, notification); err != nil {
+			t.Fatal(err)
+		}
+
+		response := respond(createTestUser(true), testOrg.ID, testEvent.ID, models.RSVPGoing)
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Event has been cancelled", response.GetMessage())
+	})
+
+	t.Run("should not rsvp to past event", func(t *testing.T) {
+		testOrg, testEvent := createTestEvent(10, -time.Hour*24, true)
+
+		response := respond(createTestUser(true), testOrg.ID, testEvent.ID, models.RSVPGoing)
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Event has already started", response.GetMessage())
+	})
+
+	t.Run("should not rsvp to draft event", func(t *testing.T) {
+		testOrg, testEvent := createTestEvent(10, time.Hour*24, false)
+
+		response := respond(createTestUser(true), testOrg.ID, testEvent.ID, models.RSVPGoing)
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+
+	t.Run("should rsvp to occurrence of recurring event", func(t *testing.T) {
+		testOrg, organizer := createTestOrg()
+		testEvent, err := testutils.CreateTestRecurringEvent(ctx, appItems.App.Store, testOrg.ID, organizer.UserProfile.ID, "FREQ=WEEKLY;COUNT=3")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		startTime, _ := time.Parse(internal.DateTimeFormat, testEvent.StartTime)
+		occurrence := startTime.AddDate(0, 0, 7).Format(internal.DateTimeFormat)
+
+		testUser := createTestUser(true)
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		payload := testutils.TestRequestData{"status": models.RSVPGoing}
+		endpoint := testEndpoint(testOrg.ID, testEvent.ID) + "?occurrence=" + occurrence
+		response, err := testutils.RunTestRequest(mux, testMethod, endpoint, headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+		assert.Equal(t, string(models.RSVPGoing), data["status"])
+		assert.Equal(t, occurrence, data["occurrenceStart"])
+
+		response = respond(testUser, testOrg.ID, testEvent.ID, models.RSVPGoing)
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Occurrence is required for recurring events", response.GetMessage())
+
+		endpoint = testEndpoint(testOrg.ID, testEvent.ID) + "?occurrence=" + startTime.Add(time.Hour).Format(internal.DateTimeFormat)
+		response, err = testutils.RunTestRequest(mux, testMethod, endpoint, headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invalid occurrence", response.GetMessage())
+	})
+
+	t.Run("should not rsvp with invalid status", func(t *testing.T) {
+		testOrg, testEvent := createTestEvent(10, time.Hour*24, true)
+
+		response := respond(createTestUser(true), testOrg.ID, testEvent.ID, models.RSVPWaitlisted)
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invalid request body", response.GetMessage())
+
+		errorMessages, ok := response.GetErrorMessages()
+		if !ok {
+			t.Fatal("failed to convert response errors to map")
+		}
+
+		assert.Equal(t, "Status should be one of going, not_going or maybe", errorMessages["status"])
+	})
+}
@@ -0,0 +1,231 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateEventOccurrence(t *testing.T) {
+	testEndpoint := func(orgID, eventID int64, occurrence, scope string) string {
+		return fmt.Sprintf("/v1/organizations/%d/events/%d/occurrences/%s?scope=%s", orgID, eventID, occurrence, scope)
+	}
+	testMethod := http.MethodPut
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func(role *models.Role, userID int64) *models.Organization {
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, true, role, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org
+	}
+
+	createTestEvent := func(orgID, userProfileID int64, rrule string) *models.Event {
+		event, err := testutils.CreateTestRecurringEvent(ctx, appItems.App.Store, orgID, userProfileID, rrule)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return event
+	}
+
+	generateRole := func(permission string) *models.Role {
+		role := &models.Role{Name: faker.Username(options.WithGenerateUniqueValues(true))}
+
+		switch permission {
+		case "valid":
+			role.Permissions = []string{internal.EventUpdate}
+		case "invalid":
+			role.Permissions = []string{internal.RoleCreate}
+		default:
+			role.Permissions = internal.Permissions
+		}
+
+		return role
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	// occurrenceTimes returns the start time of the event's nth occurrence,
+	// for a weekly event, along with times an hour later for the payload.
+	occurrenceTimes := func(event *models.Event, n int) (string, string, string) {
+		startTime, _ := time.Parse(internal.DateTimeFormat, event.StartTime)
+		occurrence := startTime.AddDate(0, 0, 7*n)
+		newStart := occurrence.Add(time.Hour)
+
+		return occurrence.Format(internal.DateTimeFormat),
+			newStart.Format(internal.DateTimeFormat),
+			newStart.Add(time.Hour * 2).Format(internal.DateTimeFormat)
+	}
+
+	generatePayload := func(startTime, endTime string) testutils.TestRequestData {
+		return testutils.TestRequestData{
+			"title":       "Updated occurrence",
+			"description": "Updated occurrence description",
+			"startTime":   startTime,
+			"endTime":     endTime,
+			"location":    "Entebbe, Uganda",
+			"capacity":    5,
+		}
+	}
+
+	getOccurrences := func(orgID, eventID int64, token string) []any {
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + token}
+		endpoint := fmt.Sprintf("/v1/organizations/%d/events/%d/occurrences", orgID, eventID)
+		response, err := testutils.RunTestRequest(mux, http.MethodGet, endpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		occurrences, ok := data["occurrences"].([]any)
+		if !ok {
+			t.Fatal("failed to convert occurrences to list")
+		}
+
+		return occurrences
+	}
+
+	t.Run("should update single occurrence", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(generateRole("valid"), testUser.UserProfile.ID)
+		testEvent := createTestEvent(testOrg.ID, testUser.UserProfile.ID, "FREQ=WEEKLY;COUNT=3")
+		occurrence, startTime, endTime := occurrenceTimes(testEvent, 1)
+
+		token := generateToken(testUser.ID, true)
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + token}
+		endpoint := testEndpoint(testOrg.ID, testEvent.ID, occurrence, "this")
+		response, err := testutils.RunTestRequest(mux, testMethod, endpoint, headers, generatePayload(startTime, endTime))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		occurrences := getOccurrences(testOrg.ID, testEvent.ID, token)
+		assert.Len(t, occurrences, 3)
+
+		updated := occurrences[1].(map[string]any)
+		assert.Equal(t, occurrence, updated["occurrenceStart"])
+		assert.Equal(t, startTime, updated["startTime"])
+		assert.Equal(t, "Updated occurrence", updated["title"])
+		assert.Equal(t, true, updated["isOverridden"])
+		assert.Equal(t, testEvent.Title, occurrences[2].(map[string]any)["title"])
+	})
+
+	t.Run("should update following occurrences", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(generateRole("valid"), testUser.UserProfile.ID)
+		testEvent := createTestEvent(testOrg.ID, testUser.UserProfile.ID, "FREQ=WEEKLY;COUNT=4")
+		occurrence, startTime, endTime := occurrenceTimes(testEvent, 2)
+
+		token := generateToken(testUser.ID, true)
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + token}
+		endpoint := testEndpoint(testOrg.ID, testEvent.ID, occurrence, "following")
+		response, err := testutils.RunTestRequest(mux, testMethod, endpoint, headers, generatePayload(startTime, endTime))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+		assert.Equal(t, startTime, data["startTime"])
+		assert.Equal(t, "FREQ=WEEKLY;COUNT=2", data["rrule"])
+
+		assert.Len(t, getOccurrences(testOrg.ID, testEvent.ID, token), 2)
+		newEventID := int64(data["id"].(float64))
+		assert.Len(t, getOccurrences(testOrg.ID, newEventID, token), 2)
+	})
+
+	t.Run("should not update occurrence with invalid permissions", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(generateRole("invalid"), testUser.UserProfile.ID)
+		testEvent := createTestEvent(testOrg.ID, testUser.UserProfile.ID, "FREQ=WEEKLY;COUNT=3")
+		occurrence, startTime, endTime := occurrenceTimes(testEvent, 1)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		endpoint := testEndpoint(testOrg.ID, testEvent.ID, occurrence, "this")
+		response, err := testutils.RunTestRequest(mux, testMethod, endpoint, headers, generatePayload(startTime, endTime))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+
+	t.Run("should not update invalid occurrence", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(generateRole("valid"), testUser.UserProfile.ID)
+		testEvent := createTestEvent(testOrg.ID, testUser.UserProfile.ID, "FREQ=WEEKLY;COUNT=3")
+		occurrence, startTime, endTime := occurrenceTimes(testEvent, 5)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		endpoint := testEndpoint(testOrg.ID, testEvent.ID, occurrence, "this")
+		response, err := testutils.RunTestRequest(mux, testMethod, endpoint, headers, generatePayload(startTime, endTime))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invalid occurrence", response.GetMessage())
+	})
+
+	t.Run("should not update occurrence with invalid scope", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(generateRole("valid"), testUser.UserProfile.ID)
+		testEvent := createTestEvent(testOrg.ID, testUser.UserProfile.ID, "FREQ=WEEKLY;COUNT=3")
+		occurrence, startTime, endTime := occurrenceTimes(testEvent, 1)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		endpoint := testEndpoint(testOrg.ID, testEvent.ID, occurrence, "all")
+		response, err := testutils.RunTestRequest(mux, testMethod, endpoint, headers, generatePayload(startTime, endTime))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Scope should be one of this or following", response.GetMessage())
+	})
+}
@@ -0,0 +1,357 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateEvent(t *testing.T) {
+	testEndpoint := func(orgID, eventID int64) string {
+		return fmt.Sprintf("/v1/organizations/%d/events/%d", orgID, eventID)
+	}
+	testMethod := http.MethodPut
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func(isActive bool, role *models.Role, userID int64) *models.Organization {
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, isActive, role, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org
+	}
+
+	createTestEvent := func(isDeleted bool, orgID int64) *models.Event {
+		event, err := testutils.CreateTestEvent(ctx, appItems.App.Store, isDeleted, orgID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return event
+	}
+
+	generateRole := func(permission string) *models.Role {
+		role := &models.Role{Name: faker.Username(options.WithGenerateUniqueValues(true))}
+
+		switch permission {
+		case "valid":
+			role.Permissions = []string{internal.EventUpdate}
+		case "invalid":
+			role.Permissions = []string{internal.EventCreate, internal.EventDelete}
+		default:
+			role.Permissions = internal.Permissions
+		}
+
+		return role
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	generatePayload := func() testutils.TestRequestData {
+		title := faker.Username(options.WithGenerateUniqueValues(true))
+		startTime, endTime := testutils.GenerateEventTimes(time.Hour * 72)
+
+		return testutils.TestRequestData{
+			"title":       title,
+			"description": title + " description",
+			"startTime":   startTime,
+			"endTime":     endTime,
+			"onlineUrl":   "https://meet.fake.link/event",
+			"capacity":    50,
+		}
+	}
+
+	t.Run("should update event", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testEvent := createTestEvent(false, testOrg.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		payload := generatePayload()
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testEvent.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		assert.Equal(t, payload["title"], data["title"])
+		assert.Equal(t, payload["description"], data["description"])
+		assert.Equal(t, "", data["location"])
+		assert.Equal(t, payload["onlineUrl"], data["onlineUrl"])
+		assert.EqualValues(t, payload["capacity"], data["capacity"])
+	})
+
+	t.Run("should not update event with invalid permissions", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("invalid"), testUser.UserProfile.ID)
+		testEvent := createTestEvent(false, testOrg.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testEvent.ID), headers, generatePayload())
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+
+	t.Run("should not update event unknown field", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testEvent := createTestEvent(false, testOrg.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		unknownField := "unknownField"
+		payload := generatePayload()
+		payload[unknownField] = "fakeData"
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testEvent.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Unknown field in request", response.GetMessage())
+
+		errorMessages, ok := response.GetErrorMessages()
+		if !ok {
+			t.Fatal("failed to convert response errors to map")
+		}
+
+		assert.Equal(t, "unknown field", errorMessages[unknownField])
+	})
+
+	t.Run("should not update cancelled event", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testEvent, err := testutils.CreateTestPublishedEvent(ctx, appItems.App.Store, false, testOrg.ID, testUser.UserProfile.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		testEvent.CancellationReason = "Cancelled for testing"
+		notification := &models.Notification{Type: models.NotificationEventCancelled, Message: testEvent.CancellationReason}
+		if err := appItems.App.Store.Events.Cancel(ctx, testEvent, notification); err != nil {
+			t.Fatal(err)
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testEvent.ID), headers, generatePayload())
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Event has been cancelled", response.GetMessage())
+	})
+
+	t.Run("should not change recurring event to single event", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testEvent, err := testutils.CreateTestRecurringEvent(ctx, appItems.App.Store, testOrg.ID, testUser.UserProfile.ID, "FREQ=WEEKLY;COUNT=5")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testEvent.ID), headers, generatePayload())
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invalid request body", response.GetMessage())
+
+		errorMessages, ok := response.GetErrorMessages()
+		if !ok {
+			t.Fatal("failed to convert response errors to map")
+		}
+
+		assert.Equal(t, "Recurring events can not be changed to single events", errorMessages["rrule"])
+	})
+
+	// createTestSeries creates a weekly series along with a payload that
+	// keeps its schedule as it is.
+	createTestSeries := func(orgID, userProfileID int64) (*models.Event, testutils.TestRequestData) {
+		testEvent, err := testutils.CreateTestRecurringEvent(ctx, appItems.App.Store, orgID, userProfileID, "FREQ=WEEKLY;COUNT=5")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		payload := generatePayload()
+		payload["startTime"] = testEvent.StartTime
+		payload["endTime"] = testEvent.EndTime
+		payload["rrule"] = testEvent.RRule
+		return testEvent, payload
+	}
+
+	createTestRSVP := func(eventID int64) {
+		user := createTestUser(true)
+		if _, err := testutils.CreateTestRSVP(ctx, appItems.App.Store, eventID, user.UserProfile.ID, models.RSVPGoing); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	assertScheduleLocked := func(t *testing.T, headers testutils.TestRequestHeaders, orgID, eventID int64, payload testutils.TestRequestData) {
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(orgID, eventID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Event has RSVPs or updated occurrences. Update its following occurrences instead", response.GetMessage())
+	}
+
+	t.Run("should update recurring event with rsvps without changing its schedule", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testEvent, payload := createTestSeries(testOrg.ID, testUser.UserProfile.ID)
+		createTestRSVP(testEvent.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testEvent.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+	})
+
+	t.Run("should update the schedule of recurring event without rsvps", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testEvent, payload := createTestSeries(testOrg.ID, testUser.UserProfile.ID)
+		payload["rrule"] = "FREQ=DAILY;COUNT=5"
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testEvent.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+	})
+
+	t.Run("should not change the start time of recurring event with rsvps", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testEvent, payload := createTestSeries(testOrg.ID, testUser.UserProfile.ID)
+		createTestRSVP(testEvent.ID)
+
+		startTime, endTime := testutils.GenerateEventTimes(time.Hour * 72)
+		payload["startTime"] = startTime
+		payload["endTime"] = endTime
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		assertScheduleLocked(t, headers, testOrg.ID, testEvent.ID, payload)
+	})
+
+	t.Run("should not change the rrule of recurring event with updated occurrences", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testEvent, payload := createTestSeries(testOrg.ID, testUser.UserProfile.ID)
+		payload["rrule"] = "FREQ=DAILY;COUNT=5"
+
+		override := &models.EventOccurrenceOverride{
+			EventID:         testEvent.ID,
+			OccurrenceStart: testEvent.StartTime,
+			Title:           testEvent.Title,
+			Description:     testEvent.Description,
+			StartTime:       testEvent.StartTime,
+			EndTime:         testEvent.EndTime,
+			Location:        testEvent.Location,
+			Capacity:        testEvent.Capacity,
+		}
+		if err := appItems.App.Store.EventOccurrenceOverrides.Save(ctx, override); err != nil {
+			t.Fatal(err)
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		assertScheduleLocked(t, headers, testOrg.ID, testEvent.ID, payload)
+	})
+
+	t.Run("should not change the exdates of recurring event with rsvps", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testEvent, payload := createTestSeries(testOrg.ID, testUser.UserProfile.ID)
+		createTestRSVP(testEvent.ID)
+
+		startTime, err := time.Parse(internal.DateTimeFormat, testEvent.StartTime)
+		if err != nil {
+			t.Fatal(err)
+		}
+		payload["exdates"] = []string{startTime.AddDate(0, 0, 7).Format(internal.DateTimeFormat)}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		assertScheduleLocked(t, headers, testOrg.ID, testEvent.ID, payload)
+	})
+
+	t.Run("should not change event with rsvps to recurring event", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testEvent := createTestEvent(false, testOrg.ID)
+		createTestRSVP(testEvent.ID)
+
+		payload := generatePayload()
+		payload["rrule"] = "FREQ=WEEKLY;COUNT=5"
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testEvent.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Events with RSVPs can not be changed to recurring events", response.GetMessage())
+	})
+
+	t.Run("should not update deleted event", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testEvent := createTestEvent(true, testOrg.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testEvent.ID), headers, generatePayload())
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+}
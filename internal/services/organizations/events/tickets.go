@@ -0,0 +1,219 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/utils"
+	"github.com/KengoWada/meetup-clone/internal/validate"
+	"github.com/skip2/go-qrcode"
+)
+
+// ticketQRCodeSize is the width and height in pixels of ticket QR codes.
+const ticketQRCodeSize = 256
+
+var errInvalidTicket = errors.New("Invalid ticket")
+
+// ticket is the data a ticket is bound to.
+type ticket struct {
+	EventID         int64   `json:"eventId"`
+	UserProfileID   int64   `json:"userProfileId"`
+	OccurrenceStart *string `json:"occurrenceStart,omitempty"`
+}
+
+type checkInPayload struct {
+	Ticket string `json:"ticket" validate:"required"`
+}
+
+// generateTicket issues a ticket for the user's RSVP to the event.
+func generateTicket(rsvp *models.EventRSVP) (string, error) {
+	data, err := json.Marshal(ticket{rsvp.EventID, rsvp.UserProfileID, rsvp.OccurrenceStart})
+	if err != nil {
+		return "", err
+	}
+
+	return utils.GeneratePurposeToken(utils.TokenPurposeTicket, string(data), []byte(cfg.SecretKey))
+}
+
+// parseTicket returns the data the ticket is bound to. It returns
+// errInvalidTicket if the ticket was not issued by generateTicket.
+func parseTicket(value string) (*ticket, error) {
+	timedToken, err := utils.ValidatePurposeToken(value, utils.TokenPurposeTicket, []byte(cfg.SecretKey), utils.NoExpiry)
+	if err != nil {
+		return nil, errInvalidTicket
+	}
+
+	var data ticket
+	if err := json.Unmarshal([]byte(timedToken.Body), &data); err != nil {
+		return nil, errInvalidTicket
+	}
+
+	return &data, nil
+}
+
+// GetEventTicket godoc
+//
+//	@Summary		Get your ticket to an event
+//	@Description	Get the QR code of your ticket to an event. Only users who are going to the event have a ticket
+//	@Tags			events
+//	@Produce		png
+//	@Param			orgID		path		int		true	"orgID the event belongs to"
+//	@Param			eventID		path		int		true	"eventID whose ticket to fetch"
+//	@Param			occurrence	query		string	false	"start time of the occurrence, required for recurring events"
+//	@Success		200			{file}		file	"ticket QR code"
+//	@Failure		400			{object}	response.DocsErrorResponse
+//	@Failure		401			{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403			{object}	response.DocsErrorResponseForbidden
+//	@Failure		500			{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/events/{eventID}/rsvp/ticket [get]
+func (h *Handler) getEventTicket(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+	event, _ := ctx.Value(internal.EventCtx).(*models.Event)
+
+	occurrenceStart, err := parseOccurrence(event, r.URL.Query().Get("occurrence"))
+	if err != nil {
+		errorMessage := response.ErrorResponse{Message: err.Error()}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	fields := []string{"event_id", "user_id"}
+	values := []any{event.ID, user.UserProfile.ID}
+	if occurrenceStart != nil {
+		fields = append(fields, "occurrence_start")
+		values = append(values, *occurrenceStart)
+	}
+
+	errorMessage := response.ErrorResponse{Message: "Only attendees who are going have a ticket"}
+	rsvp, err := h.store.EventRSVPs.Get(ctx, false, fields, values)
+	if err != nil {
+		switch err {
+		case store.ErrNotFound:
+			response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	if rsvp.Status != models.RSVPGoing || rsvp.Ticket == nil {
+		err := errors.New("user does not have a ticket")
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	image, err := qrcode.Encode(*rsvp.Ticket, qrcode.Medium, ticketQRCodeSize)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	response.SuccessResponsePNG(w, image)
+}
+
+// CheckInAttendee godoc
+//
+//	@Summary		Check in an attendee
+//	@Description	Check in an attendee by scanning their ticket. Scanning a ticket again keeps the time of the first check in
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID	path		int					true	"orgID the event belongs to"
+//	@Param			eventID	path		int					true	"eventID to check in to"
+//	@Param			payload	body		checkInPayload		true	"check in payload"
+//	@Success		200		{object}	models.EventRSVP	"attendee successfully checked in"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403		{object}	response.DocsErrorResponseForbidden
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/events/{eventID}/check-in [post]
+func (h *Handler) checkInAttendee(w http.ResponseWriter, r *http.Request) {
+	var payload checkInPayload
+	if err := utils.ReadJSON(w, r, &payload); err != nil {
+		response.ErrorResponseInvalidJSON(w, r, err)
+		return
+	}
+
+	if errorMessages, err := validate.ValidatePayload(payload, checkInPayloadErrors); err != nil {
+		switch err {
+		case validate.ErrFailedValidation:
+			errorResponse := response.NewValidationErrorResponse(errorMessages)
+			response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+	event, _ := ctx.Value(internal.EventCtx).(*models.Event)
+
+	if event.IsCancelled() {
+		err := errors.New("user tried to check in to a cancelled event")
+		errorMessage := response.ErrorResponse{Message: "Event has been cancelled"}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	errorMessage := response.ErrorResponse{Message: errInvalidTicket.Error()}
+	ticket, err := parseTicket(payload.Ticket)
+	if err != nil {
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	if ticket.EventID != event.ID {
+		err := errors.New("ticket is for another event")
+		errorMessage := response.ErrorResponse{Message: "Ticket is for another event"}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	fields := []string{"event_id", "user_id"}
+	values := []any{ticket.EventID, ticket.UserProfileID}
+	if ticket.OccurrenceStart != nil {
+		fields = append(fields, "occurrence_start")
+		values = append(values, *ticket.OccurrenceStart)
+	}
+
+	rsvp, err := h.store.EventRSVPs.Get(ctx, false, fields, values)
+	if err != nil {
+		switch err {
+		case store.ErrNotFound:
+			response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	// Only the ticket that was issued for the RSVP gets the user in.
+	if rsvp.Ticket == nil || *rsvp.Ticket != payload.Ticket {
+		err := errors.New("ticket was not issued for the rsvp")
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	if rsvp.Status != models.RSVPGoing {
+		err := errors.New("attendee is not going to the event")
+		errorMessage := response.ErrorResponse{Message: "Attendee is not going to this event"}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	if err := h.store.EventRSVPs.CheckIn(ctx, rsvp, user.UserProfile.ID); err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	response.SuccessResponseOK(w, "Done", rsvp)
+}
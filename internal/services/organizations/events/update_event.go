@@ -0,0 +1,205 @@
+package events
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/utils"
+	"github.com/KengoWada/meetup-clone/internal/validate"
+)
+
+type updateEventPayload struct {
+	Title       utils.TrimString `json:"title" validate:"required,max=255"`
+	Description utils.TrimString `json:"description" validate:"required"`
+	StartTime   string           `json:"startTime" validate:"required,is_datetime" example:"2025-03-01T18:00:00Z"`
+	EndTime     string           `json:"endTime" validate:"required,is_datetime" example:"2025-03-01T20:00:00Z"`
+	Location    utils.TrimString `json:"location" validate:"required_without=OnlineURL,max=255"`
+	OnlineURL   utils.TrimString `json:"onlineUrl" validate:"omitempty,http_url"`
+	VenueID     *int64           `json:"venueId" validate:"omitempty,min=1" example:"1"`
+	Capacity    int              `json:"capacity" validate:"required,min=1"`
+	RRule       utils.TrimString `json:"rrule" validate:"omitempty,is_rrule" example:"FREQ=WEEKLY;BYDAY=TU;COUNT=10"`
+	ExDates     []string         `json:"exdates" validate:"omitempty,dive,is_datetime"`
+	Topics      []string         `json:"topics" validate:"omitempty,max=10,dive,required,max=50" example:"golang"`
+}
+
+// UpdateOrganizationEvent godoc
+//
+//	@Summary		Update an organization event
+//	@Description	Update an organization event. A recurring event can not be changed to a single event. The start time, rrule and exdates of a recurring event with RSVPs or updated occurrences can not be changed, update its following occurrences instead
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID	path		int					true	"orgID the event belongs to"
+//	@Param			eventID	path		int					true	"eventID to update"
+//	@Param			payload	body		updateEventPayload	true	"update organization event payload"
+//	@Success		200		{object}	models.SimpleEvent	"event successfully updated"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403		{object}	response.DocsErrorResponseForbidden
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/events/{eventID} [put]
+func (h *Handler) updateEvent(w http.ResponseWriter, r *http.Request) {
+	var payload updateEventPayload
+	if err := utils.ReadJSON(w, r, &payload); err != nil {
+		response.ErrorResponseInvalidJSON(w, r, err)
+		return
+	}
+
+	if errorMessages, err := validate.ValidatePayload(payload, eventPayloadErrors); err != nil {
+		switch err {
+		case validate.ErrFailedValidation:
+			errorResponse := response.NewValidationErrorResponse(errorMessages)
+			response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	if errorMessages := validateEventTimes(payload.StartTime, payload.EndTime); errorMessages != nil {
+		err := errors.New("event end time is before start time")
+		errorResponse := response.NewValidationErrorResponse(errorMessages)
+		response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		return
+	}
+
+	ctx := r.Context()
+	event, _ := ctx.Value(internal.EventCtx).(*models.Event)
+
+	if event.IsCancelled() {
+		err := errors.New("cancelled event can not be updated")
+		errorMessage := response.ErrorResponse{Message: "Event has been cancelled"}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	// Dropping the rule would orphan the RSVPs and overrides of the series'
+	// occurrences, so a series stays a series.
+	if event.RRule != "" && payload.RRule == "" {
+		err := errors.New("recurrence rule removed from a recurring event")
+		errorMessages := response.ErrorsResponse{"rrule": "Recurring events can not be changed to single events"}
+		errorResponse := response.NewValidationErrorResponse(errorMessages)
+		response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		return
+	}
+
+	// RSVPs and overrides belong to occurrences by their start time, so the
+	// occurrences can't move once there are any. A series is split to change
+	// the following occurrences instead.
+	if (event.RRule != "" || payload.RRule != "") && scheduleChanged(event, payload) {
+		hasRSVPsOrOverrides, err := h.store.Events.HasRSVPsOrOverrides(ctx, event.ID)
+		if err != nil {
+			response.ErrorResponseInternalServerErr(w, r, err)
+			return
+		}
+
+		if hasRSVPsOrOverrides {
+			err := errors.New("schedule changed for an event with rsvps or overrides")
+			errorMessage := response.ErrorResponse{Message: "Event has RSVPs or updated occurrences. Update its following occurrences instead"}
+			if event.RRule == "" {
+				errorMessage = response.ErrorResponse{Message: "Events with RSVPs can not be changed to recurring events"}
+			}
+			response.ErrorResponseBadRequest(w, r, err, errorMessage)
+			return
+		}
+	}
+
+	errorMessages, err := validateEventVenue(ctx, h.store, event.OrganizationID, payload.VenueID)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	if errorMessages != nil {
+		err := errors.New("event venue does not belong to the organization")
+		errorResponse := response.NewValidationErrorResponse(errorMessages)
+		response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		return
+	}
+
+	event.Title = string(payload.Title)
+	event.Description = string(payload.Description)
+	event.StartTime = payload.StartTime
+	event.EndTime = payload.EndTime
+	event.Location = string(payload.Location)
+	event.OnlineURL = string(payload.OnlineURL)
+	event.VenueID = payload.VenueID
+	event.Capacity = payload.Capacity
+	event.RRule = normalizeRRule(string(payload.RRule))
+	event.ExDates = normalizeDateTimes(payload.ExDates)
+	event.Topics = normalizeTopics(payload.Topics)
+
+	if err := h.store.Events.Update(ctx, event); err != nil {
+		switch err {
+		case store.ErrNotFound:
+			res := response.ErrorResponse{Message: "Try again later"}
+			response.ErrorResponseBadRequest(w, r, err, res)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	response.SuccessResponseOK(w, "", newSimpleEvent(event))
+}
+
+// DeleteOrganizationEvent godoc
+//
+//	@Summary		Delete an organization event
+//	@Description	Delete an organization event
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID	path		int										true	"orgID the event belongs to"
+//	@Param			eventID	path		int										true	"eventID to delete"
+//	@Success		200		{object}	response.DocsSuccessResponseDoneMessage	"event successfully deleted"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403		{object}	response.DocsErrorResponseForbidden
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/events/{eventID} [delete]
+func (h *Handler) deleteEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	event, _ := ctx.Value(internal.EventCtx).(*models.Event)
+
+	if err := h.store.Events.SoftDelete(ctx, event); err != nil {
+		switch err {
+		case store.ErrNotFound:
+			res := response.ErrorResponse{Message: "Try again later"}
+			response.ErrorResponseBadRequest(w, r, err, res)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	response.SuccessResponseOK(w, "Done", nil)
+}
+
+// scheduleChanged reports whether the payload changes when the event's
+// occurrences start.
+func scheduleChanged(event *models.Event, payload updateEventPayload) bool {
+	startTime, _ := time.Parse(internal.DateTimeFormat, event.StartTime)
+	newStartTime, _ := time.Parse(internal.DateTimeFormat, payload.StartTime)
+	if !startTime.Equal(newStartTime) {
+		return true
+	}
+
+	if normalizeRRule(event.RRule) != normalizeRRule(string(payload.RRule)) {
+		return true
+	}
+
+	exDates := normalizeDateTimes(event.ExDates)
+	newExDates := normalizeDateTimes(payload.ExDates)
+	slices.Sort(exDates)
+	slices.Sort(newExDates)
+	return !slices.Equal(exDates, newExDates)
+}
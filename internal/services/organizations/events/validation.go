@@ -0,0 +1,122 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/validate"
+)
+
+var (
+	eventPayloadErrors = validate.FieldErrorMessages{
+		"title": validate.TagErrorMessages{
+			"max": "Title should be at most 255 characters",
+		},
+		"description": validate.TagErrorMessages{},
+		"startTime":   validate.TagErrorsDateTime,
+		"endTime":     validate.TagErrorsDateTime,
+		"location": validate.TagErrorMessages{
+			"required_without": "Either location or online URL is required",
+			"max":              "Location should be at most 255 characters",
+		},
+		"onlineUrl": validate.TagErrorsURL,
+		"venueId": validate.TagErrorMessages{
+			"min": "Invalid venue",
+		},
+		"capacity": validate.TagErrorMessages{
+			"min": "Capacity should be at least 1",
+		},
+		"rrule": validate.TagErrorMessages{
+			"is_rrule": "Invalid recurrence rule. Supported parts are FREQ, INTERVAL, BYDAY, COUNT and UNTIL",
+		},
+		"exdates": validate.TagErrorsDateTime,
+		"topics": validate.TagErrorMessages{
+			"max": "Events can have at most 10 topics of at most 50 characters each",
+		},
+	}
+
+	occurrencePayloadErrors = validate.FieldErrorMessages{
+		"title":       eventPayloadErrors["title"],
+		"description": eventPayloadErrors["description"],
+		"startTime":   eventPayloadErrors["startTime"],
+		"endTime":     eventPayloadErrors["endTime"],
+		"location":    eventPayloadErrors["location"],
+		"onlineUrl":   eventPayloadErrors["onlineUrl"],
+		"capacity":    eventPayloadErrors["capacity"],
+	}
+
+	cancelEventPayloadErrors = validate.FieldErrorMessages{
+		"reason": validate.TagErrorMessages{
+			"max": "Reason should be at most 500 characters",
+		},
+	}
+
+	checkInPayloadErrors = validate.FieldErrorMessages{
+		"ticket": validate.TagErrorMessages{},
+	}
+
+	rsvpPayloadErrors = validate.FieldErrorMessages{
+		"status": validate.TagErrorMessages{
+			"oneof": "Status should be one of going, not_going or maybe",
+		},
+	}
+)
+
+// validateEventTimes checks that an event ends after it starts. Both times
+// are expected to have already passed the `is_datetime` validation.
+func validateEventTimes(startTime, endTime string) response.ErrorsResponse {
+	start, _ := time.Parse(internal.DateTimeFormat, startTime)
+	end, _ := time.Parse(internal.DateTimeFormat, endTime)
+
+	if !end.After(start) {
+		return response.ErrorsResponse{"endTime": "End time must be after start time"}
+	}
+
+	return nil
+}
+
+// validateEventVenue checks that the venue the event is held at belongs to
+// the organization hosting the event. Events without a venue are valid.
+func validateEventVenue(ctx context.Context, appStore store.Store, orgID int64, venueID *int64) (response.ErrorsResponse, error) {
+	if venueID == nil {
+		return nil, nil
+	}
+
+	fields := []string{"id", "org_id"}
+	values := []any{*venueID, orgID}
+	if _, err := appStore.Venues.Get(ctx, false, fields, values); err != nil {
+		switch err {
+		case store.ErrNotFound:
+			return response.ErrorsResponse{"venueId": "Invalid venue"}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+// validateEventOpenForRSVPs checks that users can still respond to the
+// event, or to the occurrence of a recurring event. It returns the message
+// to send back to the user when they can't.
+func validateEventOpenForRSVPs(event *models.Event, occurrenceStart *string) (string, bool) {
+	if event.IsCancelled() {
+		return "Event has been cancelled", false
+	}
+
+	start := event.StartTime
+	if occurrenceStart != nil {
+		start = *occurrenceStart
+	}
+
+	startTime, _ := time.Parse(internal.DateTimeFormat, start)
+	if !startTime.After(time.Now()) {
+		return "Event has already started", false
+	}
+
+	return "", true
+}
@@ -0,0 +1,78 @@
+package organizations
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/middleware"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+)
+
+type membershipResponse struct {
+	ID          int64               `json:"id"`
+	IsOwner     bool                `json:"isOwner"`
+	JoinedAt    string              `json:"joinedAt"`
+	Role        models.SimpleRole   `json:"role"`
+	Permissions map[string][]string `json:"permissions"`
+}
+
+// GetMembership godoc
+//
+//	@Summary		Get your membership of an organization
+//	@Description	Get your membership of an organization along with your role and the permissions you hold, grouped the same way as the role permissions
+//	@Tags			organizations
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID	path		int					true	"orgID whose membership to fetch"
+//	@Success		200		{object}	membershipResponse	"membership successfully fetched"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403		{object}	response.DocsErrorResponseForbidden
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/me [get]
+func (h *Handler) getMembership(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+	organization, _ := ctx.Value(internal.OrgCtx).(*models.Organization)
+
+	member, role, err := middleware.GetOrgMembership(ctx, r, h.store, h.cacheStore, user.UserProfile.ID, organization.ID)
+	if err != nil {
+		switch err {
+		case store.ErrNotFound:
+			err := errors.New("user is not an active member of the organization")
+			response.ErrorResponseForbidden(w, r, err)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	permissions := make(map[string][]string, len(internal.PermissionsMap))
+	for group, groupPermissions := range internal.PermissionsMap {
+		permissions[group] = []string{}
+		for _, permission := range groupPermissions {
+			if slices.Contains(role.Permissions, permission) {
+				permissions[group] = append(permissions[group], permission)
+			}
+		}
+	}
+
+	membership := membershipResponse{
+		ID:       member.ID,
+		IsOwner:  member.IsOwner,
+		JoinedAt: member.CreatedAt,
+		Role: models.SimpleRole{
+			ID:          role.ID,
+			Name:        role.Name,
+			Description: role.Description,
+			Permissions: role.Permissions,
+		},
+		Permissions: permissions,
+	}
+	response.SuccessResponseOK(w, "", membership)
+}
@@ -11,7 +11,7 @@ import (
 // GetOrganization godoc
 //
 //	@Summary		Get an organization
-//	@Description	Get an organization
+//	@Description	Get an organization along with its published events
 //	@Tags			organizations
 //	@Accept			json
 //	@Produce		json
@@ -27,12 +27,19 @@ func (h *Handler) getOrganization(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	organization, _ := ctx.Value(internal.OrgCtx).(*models.Organization)
 
+	events, err := h.store.Events.GetByOrgID(ctx, organization.ID, false)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
 	orgData := orgResponse{
 		ID:          organization.ID,
 		Name:        organization.Name,
 		Description: organization.Description,
 		ProfilePic:  organization.ProfilePic,
 		CreatedAt:   organization.CreatedAt,
+		Events:      events,
 	}
 	response.SuccessResponseOK(w, "", orgData)
 }
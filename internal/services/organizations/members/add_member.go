@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/KengoWada/meetup-clone/internal"
 	"github.com/KengoWada/meetup-clone/internal/models"
@@ -21,7 +22,7 @@ type inviteMemberPayload struct {
 // InviteOrganizationMember godoc
 //
 //	@Summary		Invite an organization member
-//	@Description	Invite an organization member
+//	@Description	Invite an organization member. People without an account are invited by email and the invite is attached to their profile once they activate their account
 //	@Tags			members
 //	@Accept			json
 //	@Produce		json
@@ -51,63 +52,89 @@ func (h *Handler) inviteMember(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	organization, _ := ctx.Value(internal.OrgCtx).(*models.Organization)
 
-	exists, err := roleExists(ctx, h.store, payload.RoleID, organization.ID)
+	errorMessages, err = validateAssignableRole(r, h.store, h.cacheStore, payload.RoleID, organization.ID)
 	if err != nil {
 		response.ErrorResponseInternalServerErr(w, r, err)
 		return
 	}
 
-	if !exists {
-		err := errors.New("invalid role id")
-		errorResponse := response.NewValidationErrorResponse(response.ErrorsResponse{"roleId": "invalid role id"})
+	if errorMessages != nil {
+		err := errors.New("role can not be assigned")
+		errorResponse := response.NewValidationErrorResponse(errorMessages)
 		response.ErrorResponseBadRequest(w, r, err, errorResponse)
 		return
 	}
 
+	invite := &models.OrganizationInvite{
+		OrganizationID: organization.ID,
+		RoleID:         payload.RoleID,
+		ExpiresAt:      inviteExpiresAt(),
+	}
+
+	// People without an active account are invited by email. The invite is
+	// attached to their profile once they activate their account.
 	fields := []string{"email", "is_active"}
 	values := []any{string(payload.Email), true}
 	user, err := h.store.Users.GetWithProfile(ctx, false, fields, values)
-	if err != nil {
-		switch err {
-		case store.ErrNotFound:
-			response.SuccessResponseCreated(w, "Invite sent", nil)
-		default:
-			response.ErrorResponseInternalServerErr(w, r, err)
-		}
+	switch err {
+	case nil:
+		invite.UserProfileID = &user.UserProfile.ID
+		fields = []string{"user_id", "org_id"}
+		values = []any{user.UserProfile.ID, organization.ID}
+	case store.ErrNotFound:
+		email := string(payload.Email)
+		invite.Email = &email
+		fields = []string{"email", "org_id"}
+		values = []any{email, organization.ID}
+	default:
+		response.ErrorResponseInternalServerErr(w, r, err)
 		return
 	}
 
-	fields = []string{"user_id", "org_id"}
-	values = []any{user.UserProfile.ID, organization.ID}
-	_, err = h.store.OrganizationInvites.Get(ctx, false, fields, values)
+	existingInvite, err := h.store.OrganizationInvites.Get(ctx, false, fields, values)
 	if err != nil && err != store.ErrNotFound {
 		response.ErrorResponseInternalServerErr(w, r, err)
 		return
 	}
 
-	if err == nil {
+	if err == nil && existingInvite.IsPending() {
+		if !existingInvite.IsExpired() {
+			response.SuccessResponseCreated(w, "Invite sent", nil)
+			return
+		}
+
+		existingInvite.RoleID = payload.RoleID
+		existingInvite.ExpiresAt = inviteExpiresAt()
+		if err := h.store.OrganizationInvites.Resend(ctx, existingInvite); err != nil {
+			switch err {
+			case store.ErrNotFound:
+				errorResponse := response.ErrorResponse{Message: "Try again later"}
+				response.ErrorResponseBadRequest(w, r, err, errorResponse)
+			default:
+				response.ErrorResponseInternalServerErr(w, r, err)
+			}
+			return
+		}
+
 		response.SuccessResponseCreated(w, "Invite sent", nil)
 		return
 	}
 
-	_, err = h.store.OrganizationMembers.Get(ctx, false, fields, values)
-	if err != nil && err != store.ErrNotFound {
-		response.ErrorResponseInternalServerErr(w, r, err)
-		return
-	}
+	if user != nil {
+		_, err = h.store.OrganizationMembers.Get(ctx, false, fields, values)
+		if err != nil && err != store.ErrNotFound {
+			response.ErrorResponseInternalServerErr(w, r, err)
+			return
+		}
 
-	if err == nil {
-		err := errors.New("tried to invite team member")
-		errorResponse := response.ErrorResponse{Message: "User is already a member"}
-		response.ErrorResponseBadRequest(w, r, err, errorResponse)
-		return
+		if err == nil {
+			err := errors.New("tried to invite team member")
+			errorResponse := response.ErrorResponse{Message: "User is already a member"}
+			response.ErrorResponseBadRequest(w, r, err, errorResponse)
+			return
+		}
 	}
 
-	invite := &models.OrganizationInvite{
-		OrganizationID: organization.ID,
-		UserProfileID:  user.UserProfile.ID,
-		RoleID:         payload.RoleID,
-	}
 	if err := h.store.OrganizationInvites.Create(ctx, invite); err != nil {
 		response.ErrorResponseInternalServerErr(w, r, err)
 		return
@@ -116,6 +143,12 @@ func (h *Handler) inviteMember(w http.ResponseWriter, r *http.Request) {
 	response.SuccessResponseCreated(w, "Invite sent", nil)
 }
 
+// inviteExpiresAt returns when an invite sent now stops being valid.
+func inviteExpiresAt() string {
+	ttl := time.Duration(cfg.InviteExp) * time.Hour
+	return time.Now().UTC().Add(ttl).Format(internal.DateTimeFormat)
+}
+
 func roleExists(ctx context.Context, appStore store.Store, roleID, orgID int64) (bool, error) {
 	fields := []string{"id", "org_id"}
 	values := []any{roleID, orgID}
@@ -0,0 +1,174 @@
+package members
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/middleware"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/utils"
+)
+
+const (
+	// defaultMembersLimit is how many members are on a page when the client
+	// does not say.
+	defaultMembersLimit = 20
+	// maxMembersLimit is the most members a client can ask for on a page.
+	maxMembersLimit = 100
+)
+
+// managePermissions are the permissions that allow a member to see the
+// full details of the organization's members.
+var managePermissions = []string{internal.MemberAdd, internal.MemberRemove, internal.MemberRoleUpdate}
+
+// GetOrganizationMembers godoc
+//
+//	@Summary		Get an organization's members
+//	@Description	Get the members of an organization along with their roles. Members who can manage members also get each member's ID, role ID and email. Pass the returned nextCursor as the cursor to get the next page
+//	@Tags			members
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID		path		int						true	"orgID whose members to fetch"
+//	@Param			role_id		query		int						false	"only return members with this role"
+//	@Param			username	query		string					false	"only return members whose username starts with this text"
+//	@Param			sort		query		string					false	"field to sort the members by, prefixed with - to sort in descending order. Defaults to joinedAt"	Enums(joinedAt, -joinedAt, username, -username)
+//	@Param			limit		query		int						false	"number of members on a page, defaults to 20 and is at most 100"
+//	@Param			cursor		query		string					false	"cursor of the page to fetch"
+//	@Success		200			{object}	[]models.ManagedMember	"members successfully fetched"
+//	@Failure		400			{object}	response.DocsErrorResponse
+//	@Failure		401			{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403			{object}	response.DocsErrorResponseForbidden
+//	@Failure		500			{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/members [get]
+func (h *Handler) getMembers(w http.ResponseWriter, r *http.Request) {
+	filter, err := parseMembersQuery(r)
+	if err != nil {
+		errorMessage := response.ErrorResponse{Message: err.Error()}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+	organization, _ := ctx.Value(internal.OrgCtx).(*models.Organization)
+
+	fields := []string{"user_id", "org_id"}
+	values := []any{user.UserProfile.ID, organization.ID}
+	if _, err := h.store.OrganizationMembers.Get(ctx, false, fields, values); err != nil {
+		switch err {
+		case store.ErrNotFound:
+			err := errors.New("user is not a member of the organization")
+			response.ErrorResponseForbidden(w, r, err)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	canManage, err := middleware.MemberHasOrgPermission(ctx, r, h.store, h.cacheStore, managePermissions, user.UserProfile.ID, organization.ID)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	// Fetch an extra member to find out if there is another page.
+	limit := filter.Limit
+	filter.Limit++
+
+	members, err := h.store.OrganizationMembers.GetByOrgID(ctx, organization.ID, filter)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	var nextCursor *string
+	if len(members) > limit {
+		members = members[:limit]
+		last := members[limit-1]
+
+		position := store.MemberCursor{Sort: filter.Sort, Value: last.JoinedAt, ID: last.ID}
+		if filter.Sort == store.MemberSortUsername {
+			position.Value = last.Username
+		}
+
+		cursor, err := utils.EncodeCursor(position)
+		if err != nil {
+			response.ErrorResponseInternalServerErr(w, r, err)
+			return
+		}
+		nextCursor = &cursor
+	}
+
+	data := map[string]any{"nextCursor": nextCursor}
+	if canManage {
+		if members == nil {
+			members = []*models.ManagedMember{}
+		}
+		data["members"] = members
+	} else {
+		directory := []models.DirectoryMember{}
+		for _, member := range members {
+			directory = append(directory, member.DirectoryMember)
+		}
+		data["members"] = directory
+	}
+
+	response.SuccessResponseOK(w, "", data)
+}
+
+func parseMembersQuery(r *http.Request) (store.MemberFilter, error) {
+	query := r.URL.Query()
+
+	filter := store.MemberFilter{
+		Username: strings.TrimSpace(query.Get("username")),
+		Sort:     store.MemberSortJoinedAt,
+		Limit:    defaultMembersLimit,
+	}
+
+	if value := query.Get("role_id"); value != "" {
+		roleID, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || roleID < 1 {
+			return store.MemberFilter{}, errors.New("Invalid role ID")
+		}
+		filter.RoleID = roleID
+	}
+
+	if value := query.Get("sort"); value != "" {
+		filter.Descending = strings.HasPrefix(value, "-")
+		filter.Sort = strings.TrimPrefix(value, "-")
+		if filter.Sort != store.MemberSortJoinedAt && filter.Sort != store.MemberSortUsername {
+			return store.MemberFilter{}, errors.New("Sort should be one of joinedAt, -joinedAt, username or -username")
+		}
+	}
+
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 1 || limit > maxMembersLimit {
+			return store.MemberFilter{}, errors.New("Limit should be a number between 1 and 100")
+		}
+		filter.Limit = limit
+	}
+
+	if value := query.Get("cursor"); value != "" {
+		var cursor store.MemberCursor
+		if err := utils.DecodeCursor(value, &cursor); err != nil || cursor.Sort != filter.Sort {
+			return store.MemberFilter{}, errors.New("Invalid cursor")
+		}
+
+		if cursor.Sort == store.MemberSortJoinedAt {
+			if _, err := time.Parse(time.RFC3339Nano, cursor.Value); err != nil {
+				return store.MemberFilter{}, errors.New("Invalid cursor")
+			}
+		}
+		filter.After = &cursor
+	}
+
+	return filter, nil
+}
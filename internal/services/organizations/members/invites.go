@@ -0,0 +1,122 @@
+package members
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+)
+
+// GetOrganizationInvites godoc
+//
+//	@Summary		Get an organization's pending invites
+//	@Description	Get the invites an organization has sent that have not been responded to, newest first. Expired invites are included so they can be re-sent
+//	@Tags			members
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID	path		int					true	"orgID whose invites to fetch"
+//	@Success		200		{object}	[]models.SentInvite	"invites successfully fetched"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403		{object}	response.DocsErrorResponseForbidden
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/members/invites [get]
+func (h *Handler) getInvites(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	organization, _ := ctx.Value(internal.OrgCtx).(*models.Organization)
+
+	invites, err := h.store.OrganizationInvites.GetPendingByOrgID(ctx, organization.ID)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	response.SuccessResponseOK(w, "", map[string]any{"invites": invites})
+}
+
+// ResendOrganizationInvite godoc
+//
+//	@Summary		Re-send an organization invite
+//	@Description	Re-send a pending organization invite, resetting the time it expires
+//	@Tags			members
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID		path		int							true	"orgID the invite belongs to"
+//	@Param			inviteID	path		int							true	"inviteID to re-send"
+//	@Success		200			{object}	models.OrganizationInvite	"invite successfully re-sent"
+//	@Failure		400			{object}	response.DocsErrorResponse
+//	@Failure		401			{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403			{object}	response.DocsErrorResponseForbidden
+//	@Failure		500			{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/members/invites/{inviteID}/resend [patch]
+func (h *Handler) resendInvite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	invite, _ := ctx.Value(internal.InviteCtx).(*models.OrganizationInvite)
+
+	if !invite.IsPending() {
+		err := errors.New("member tried to re-send an invite that was responded to")
+		errorMessage := response.ErrorResponse{Message: "Invite has already been responded to"}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	invite.ExpiresAt = inviteExpiresAt()
+	if err := h.store.OrganizationInvites.Resend(ctx, invite); err != nil {
+		switch err {
+		case store.ErrNotFound:
+			errorMessage := response.ErrorResponse{Message: "Try again later"}
+			response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	response.SuccessResponseOK(w, "Invite sent", invite)
+}
+
+// RevokeOrganizationInvite godoc
+//
+//	@Summary		Revoke an organization invite
+//	@Description	Revoke a pending organization invite so that it can no longer be accepted
+//	@Tags			members
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID		path		int									true	"orgID the invite belongs to"
+//	@Param			inviteID	path		int									true	"inviteID to revoke"
+//	@Success		200			{object}	response.DocsResponseMessageOnly	"invite successfully revoked"
+//	@Failure		400			{object}	response.DocsErrorResponse
+//	@Failure		401			{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403			{object}	response.DocsErrorResponseForbidden
+//	@Failure		500			{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/members/invites/{inviteID} [delete]
+func (h *Handler) revokeInvite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	invite, _ := ctx.Value(internal.InviteCtx).(*models.OrganizationInvite)
+
+	if !invite.IsPending() {
+		err := errors.New("member tried to revoke an invite that was responded to")
+		errorMessage := response.ErrorResponse{Message: "Invite has already been responded to"}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	if err := h.store.OrganizationInvites.Revoke(ctx, invite); err != nil {
+		switch err {
+		case store.ErrNotFound:
+			errorMessage := response.ErrorResponse{Message: "Try again later"}
+			response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	response.SuccessResponseOK(w, "Invite revoked", nil)
+}
@@ -0,0 +1,329 @@
+package members
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/utils"
+	"github.com/KengoWada/meetup-clone/internal/validate"
+)
+
+var errInvalidJoinLink = errors.New("join link is invalid")
+
+type joinLinkPayload struct {
+	RoleID    int64   `json:"roleId" validate:"required"`
+	MaxUses   *int    `json:"maxUses" validate:"omitempty,min=1" example:"10"`
+	ExpiresAt *string `json:"expiresAt" validate:"omitempty,is_datetime" example:"2025-01-01T00:00:00Z"`
+}
+
+type joinOrganizationPayload struct {
+	Token string `json:"token" validate:"required"`
+}
+
+// joinLinkToken is the data a join link's token is bound to.
+type joinLinkToken struct {
+	OrganizationID int64   `json:"orgId"`
+	RoleID         int64   `json:"roleId"`
+	MaxUses        *int    `json:"maxUses"`
+	ExpiresAt      *string `json:"expiresAt"`
+}
+
+// generateJoinLinkToken creates the token users send back to join the
+// organization with the link.
+func generateJoinLinkToken(link *models.OrganizationJoinLink) (string, error) {
+	data, err := json.Marshal(joinLinkToken{link.OrganizationID, link.RoleID, link.MaxUses, link.ExpiresAt})
+	if err != nil {
+		return "", err
+	}
+
+	return utils.GeneratePurposeToken(utils.TokenPurposeJoinLink, string(data), []byte(cfg.SecretKey))
+}
+
+// parseJoinLinkToken returns the data the token is bound to. It returns
+// errInvalidJoinLink if the token was not issued by generateJoinLinkToken.
+func parseJoinLinkToken(value string) (*joinLinkToken, error) {
+	timedToken, err := utils.ValidatePurposeToken(value, utils.TokenPurposeJoinLink, []byte(cfg.SecretKey), utils.NoExpiry)
+	if err != nil {
+		return nil, errInvalidJoinLink
+	}
+
+	var data joinLinkToken
+	if err := json.Unmarshal([]byte(timedToken.Body), &data); err != nil {
+		return nil, errInvalidJoinLink
+	}
+
+	return &data, nil
+}
+
+// CreateJoinLink godoc
+//
+//	@Summary		Create an organization join link
+//	@Description	Create a link that anyone can use to join the organization with a role. Links can optionally be limited to a number of uses and an expiry time
+//	@Tags			members
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID	path		int							true	"orgID to create the link for"
+//	@Param			payload	body		joinLinkPayload				true	"join link payload"
+//	@Success		201		{object}	models.OrganizationJoinLink	"join link successfully created"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403		{object}	response.DocsErrorResponseForbidden
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/members/links [post]
+func (h *Handler) createJoinLink(w http.ResponseWriter, r *http.Request) {
+	var payload joinLinkPayload
+	if err := utils.ReadJSON(w, r, &payload); err != nil {
+		response.ErrorResponseInvalidJSON(w, r, err)
+		return
+	}
+
+	if errorMessages, err := validate.ValidatePayload(payload, joinLinkPayloadErrors); err != nil {
+		switch err {
+		case validate.ErrFailedValidation:
+			errorResponse := response.NewValidationErrorResponse(errorMessages)
+			response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	if errorMessages := validateJoinLinkExpiry(payload.ExpiresAt); errorMessages != nil {
+		err := errors.New("join link expires in the past")
+		errorResponse := response.NewValidationErrorResponse(errorMessages)
+		response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		return
+	}
+
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+	organization, _ := ctx.Value(internal.OrgCtx).(*models.Organization)
+
+	errorMessages, err := validateAssignableRole(r, h.store, h.cacheStore, payload.RoleID, organization.ID)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	if errorMessages != nil {
+		err := errors.New("role can not be assigned")
+		errorResponse := response.NewValidationErrorResponse(errorMessages)
+		response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		return
+	}
+
+	link := &models.OrganizationJoinLink{
+		OrganizationID: organization.ID,
+		RoleID:         payload.RoleID,
+		MaxUses:        payload.MaxUses,
+		ExpiresAt:      payload.ExpiresAt,
+		CreatedBy:      user.UserProfile.ID,
+	}
+	if link.Token, err = generateJoinLinkToken(link); err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	if err := h.store.OrganizationJoinLinks.Create(ctx, link); err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	response.SuccessResponseCreated(w, "Join link created", link)
+}
+
+// GetJoinLinks godoc
+//
+//	@Summary		Get an organization's join links
+//	@Description	Get an organization's join links, newest first
+//	@Tags			members
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID	path		int								true	"orgID whose links to fetch"
+//	@Success		200		{object}	[]models.OrganizationJoinLink	"join links successfully fetched"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403		{object}	response.DocsErrorResponseForbidden
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/members/links [get]
+func (h *Handler) getJoinLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	organization, _ := ctx.Value(internal.OrgCtx).(*models.Organization)
+
+	links, err := h.store.OrganizationJoinLinks.GetByOrgID(ctx, organization.ID)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	response.SuccessResponseOK(w, "", map[string]any{"links": links})
+}
+
+// DisableJoinLink godoc
+//
+//	@Summary		Disable an organization join link
+//	@Description	Disable an organization join link so that it can no longer be used to join
+//	@Tags			members
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID	path		int							true	"orgID the link belongs to"
+//	@Param			linkID	path		int							true	"linkID to disable"
+//	@Success		200		{object}	models.OrganizationJoinLink	"join link successfully disabled"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403		{object}	response.DocsErrorResponseForbidden
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/members/links/{linkID}/disable [patch]
+func (h *Handler) disableJoinLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	link, _ := ctx.Value(internal.JoinLinkCtx).(*models.OrganizationJoinLink)
+
+	if link.IsDisabled() {
+		err := errors.New("member tried to disable a disabled join link")
+		errorMessage := response.ErrorResponse{Message: "Join link is already disabled"}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	if err := h.store.OrganizationJoinLinks.Disable(ctx, link); err != nil {
+		switch err {
+		case store.ErrNotFound:
+			errorMessage := response.ErrorResponse{Message: "Try again later"}
+			response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	response.SuccessResponseOK(w, "Join link disabled", link)
+}
+
+// JoinOrganization godoc
+//
+//	@Summary		Join an organization with a join link
+//	@Description	Join an organization with the role of the join link the token belongs to
+//	@Tags			members
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID	path		int							true	"orgID to join"
+//	@Param			payload	body		joinOrganizationPayload		true	"join organization payload"
+//	@Success		200		{object}	models.OrganizationMember	"successfully joined the organization"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403		{object}	response.DocsErrorResponseForbidden
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/members/join [post]
+func (h *Handler) joinOrganization(w http.ResponseWriter, r *http.Request) {
+	var payload joinOrganizationPayload
+	if err := utils.ReadJSON(w, r, &payload); err != nil {
+		response.ErrorResponseInvalidJSON(w, r, err)
+		return
+	}
+
+	if errorMessages, err := validate.ValidatePayload(payload, joinOrganizationPayloadErrors); err != nil {
+		switch err {
+		case validate.ErrFailedValidation:
+			errorResponse := response.NewValidationErrorResponse(errorMessages)
+			response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+	organization, _ := ctx.Value(internal.OrgCtx).(*models.Organization)
+	invalidLinkMessage := response.ErrorResponse{Message: "Join link is invalid"}
+
+	data, err := parseJoinLinkToken(payload.Token)
+	if err != nil || data.OrganizationID != organization.ID {
+		response.ErrorResponseBadRequest(w, r, errInvalidJoinLink, invalidLinkMessage)
+		return
+	}
+
+	fields := []string{"token", "org_id"}
+	values := []any{payload.Token, organization.ID}
+	link, err := h.store.OrganizationJoinLinks.Get(ctx, false, fields, values)
+	if err != nil {
+		switch err {
+		case store.ErrNotFound:
+			response.ErrorResponseBadRequest(w, r, err, invalidLinkMessage)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	var message string
+	switch {
+	case link.IsDisabled():
+		message = "Join link has been disabled"
+	case link.IsExpired():
+		message = "Join link has expired"
+	case link.IsUsedUp():
+		message = "Join link has reached its maximum number of uses"
+	}
+
+	if message != "" {
+		err := errors.New("user tried to join with an unusable join link")
+		errorMessage := response.ErrorResponse{Message: message}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	exists, err := roleExists(ctx, h.store, link.RoleID, organization.ID)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	if !exists {
+		err := errors.New("user tried to join with a join link for a deleted role")
+		errorMessage := response.ErrorResponse{Message: "Join link is no longer valid"}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	fields = []string{"user_id", "org_id"}
+	values = []any{user.UserProfile.ID, organization.ID}
+	_, err = h.store.OrganizationMembers.Get(ctx, false, fields, values)
+	if err != nil && err != store.ErrNotFound {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	if err == nil {
+		err := errors.New("member tried to join their organization")
+		errorMessage := response.ErrorResponse{Message: "You are already a member of this organization"}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	member := &models.OrganizationMember{UserProfileID: user.UserProfile.ID}
+	if err := h.store.OrganizationJoinLinks.Join(ctx, link, member); err != nil {
+		switch err {
+		case store.ErrNotFound:
+			errorMessage := response.ErrorResponse{Message: "Try again later"}
+			response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		case store.ErrDuplicateMember:
+			errorMessage := response.ErrorResponse{Message: "You are already a member of this organization"}
+			response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	response.SuccessResponseOK(w, "Joined organization", member)
+}
@@ -0,0 +1,115 @@
+package members
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/go-chi/chi/v5"
+)
+
+func getInvite(appStore store.Store) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			inviteID, err := strconv.ParseInt(chi.URLParam(r, "inviteID"), 10, 64)
+			if err != nil {
+				errorMessage := response.ErrorResponse{Message: "Invalid invite ID"}
+				response.ErrorResponseBadRequest(w, r, err, errorMessage)
+				return
+			}
+
+			ctx := r.Context()
+			organization, _ := ctx.Value(internal.OrgCtx).(*models.Organization)
+
+			fields := []string{"id", "org_id"}
+			values := []any{inviteID, organization.ID}
+			invite, err := appStore.OrganizationInvites.Get(ctx, false, fields, values)
+			if err != nil {
+				switch err {
+				case store.ErrNotFound:
+					response.ErrorResponseForbidden(w, r, err)
+				default:
+					response.ErrorResponseInternalServerErr(w, r, err)
+				}
+				return
+			}
+
+			ctx = context.WithValue(ctx, internal.InviteCtx, invite)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
+
+func getJoinLink(appStore store.Store) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			linkID, err := strconv.ParseInt(chi.URLParam(r, "linkID"), 10, 64)
+			if err != nil {
+				errorMessage := response.ErrorResponse{Message: "Invalid join link ID"}
+				response.ErrorResponseBadRequest(w, r, err, errorMessage)
+				return
+			}
+
+			ctx := r.Context()
+			organization, _ := ctx.Value(internal.OrgCtx).(*models.Organization)
+
+			fields := []string{"id", "org_id"}
+			values := []any{linkID, organization.ID}
+			link, err := appStore.OrganizationJoinLinks.Get(ctx, false, fields, values)
+			if err != nil {
+				switch err {
+				case store.ErrNotFound:
+					response.ErrorResponseForbidden(w, r, err)
+				default:
+					response.ErrorResponseInternalServerErr(w, r, err)
+				}
+				return
+			}
+
+			ctx = context.WithValue(ctx, internal.JoinLinkCtx, link)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
+
+func getMember(appStore store.Store) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			memberID, err := strconv.ParseInt(chi.URLParam(r, "memberID"), 10, 64)
+			if err != nil {
+				errorMessage := response.ErrorResponse{Message: "Invalid member ID"}
+				response.ErrorResponseBadRequest(w, r, err, errorMessage)
+				return
+			}
+
+			ctx := r.Context()
+			organization, _ := ctx.Value(internal.OrgCtx).(*models.Organization)
+
+			fields := []string{"id", "org_id"}
+			values := []any{memberID, organization.ID}
+			member, err := appStore.OrganizationMembers.Get(ctx, false, fields, values)
+			if err != nil {
+				switch err {
+				case store.ErrNotFound:
+					response.ErrorResponseForbidden(w, r, err)
+				default:
+					response.ErrorResponseInternalServerErr(w, r, err)
+				}
+				return
+			}
+
+			ctx = context.WithValue(ctx, internal.MemberCtx, member)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
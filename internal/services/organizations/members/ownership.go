@@ -0,0 +1,177 @@
+package members
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/logger"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/utils"
+	"github.com/KengoWada/meetup-clone/internal/validate"
+)
+
+type transferOwnershipPayload struct {
+	MemberID int64 `json:"memberId" validate:"required"`
+}
+
+// LeaveOrganization godoc
+//
+//	@Summary		Leave an organization
+//	@Description	Leave an organization you are a member of. The last owner of an organization has to transfer ownership to another member first
+//	@Tags			members
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID	path		int									true	"orgID to leave"
+//	@Success		200		{object}	response.DocsResponseMessageOnly	"organization successfully left"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403		{object}	response.DocsErrorResponseForbidden
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/members/leave [post]
+func (h *Handler) leaveOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+	organization, _ := ctx.Value(internal.OrgCtx).(*models.Organization)
+
+	fields := []string{"user_id", "org_id"}
+	values := []any{user.UserProfile.ID, organization.ID}
+	member, err := h.store.OrganizationMembers.Get(ctx, false, fields, values)
+	if err != nil {
+		switch err {
+		case store.ErrNotFound:
+			err := errors.New("user is not a member of the organization")
+			response.ErrorResponseForbidden(w, r, err)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	if err := h.store.OrganizationMembers.Leave(ctx, member); err != nil {
+		switch err {
+		case store.ErrLastOwner:
+			errorMessage := response.ErrorResponse{Message: "Transfer ownership to another member before leaving the organization"}
+			response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		case store.ErrNotFound:
+			errorMessage := response.ErrorResponse{Message: "Try again later"}
+			response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	if cfg.CacheConfig.Enabled {
+		if err := h.cacheStore.OrganizationMembers.Delete(member.UserProfileID, member.OrganizationID); err != nil {
+			logger.ErrLoggerCache(r, err)
+		}
+	}
+
+	response.SuccessResponseOK(w, "Left organization", nil)
+}
+
+// TransferOwnership godoc
+//
+//	@Summary		Transfer ownership of an organization
+//	@Description	Hand the ownership of an organization over to another member. Only the owner can do this and the transfer is recorded
+//	@Tags			members
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID	path		int							true	"orgID whose ownership to transfer"
+//	@Param			payload	body		transferOwnershipPayload	true	"transfer ownership payload"
+//	@Success		200		{object}	models.OwnershipTransfer	"ownership successfully transferred"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403		{object}	response.DocsErrorResponseForbidden
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/members/transfer-ownership [post]
+func (h *Handler) transferOwnership(w http.ResponseWriter, r *http.Request) {
+	var payload transferOwnershipPayload
+	if err := utils.ReadJSON(w, r, &payload); err != nil {
+		response.ErrorResponseInvalidJSON(w, r, err)
+		return
+	}
+
+	if errorMessages, err := validate.ValidatePayload(payload, transferOwnershipPayloadErrors); err != nil {
+		switch err {
+		case validate.ErrFailedValidation:
+			errorResponse := response.NewValidationErrorResponse(errorMessages)
+			response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+	organization, _ := ctx.Value(internal.OrgCtx).(*models.Organization)
+
+	fields := []string{"user_id", "org_id"}
+	values := []any{user.UserProfile.ID, organization.ID}
+	owner, err := h.store.OrganizationMembers.Get(ctx, false, fields, values)
+	if err != nil {
+		switch err {
+		case store.ErrNotFound:
+			err := errors.New("user is not a member of the organization")
+			response.ErrorResponseForbidden(w, r, err)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	if !owner.IsOwner {
+		err := errors.New("member who is not an owner tried to transfer ownership")
+		response.ErrorResponseForbidden(w, r, err)
+		return
+	}
+
+	fields = []string{"id", "org_id"}
+	values = []any{payload.MemberID, organization.ID}
+	member, err := h.store.OrganizationMembers.Get(ctx, false, fields, values)
+	if err != nil {
+		switch err {
+		case store.ErrNotFound:
+			errorResponse := response.NewValidationErrorResponse(response.ErrorsResponse{"memberId": "invalid member id"})
+			response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	if member.IsOwner {
+		err := errors.New("ownership transferred to an owner")
+		errorResponse := response.NewValidationErrorResponse(response.ErrorsResponse{"memberId": "Member is already an owner"})
+		response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		return
+	}
+
+	transfer, err := h.store.OrganizationMembers.TransferOwnership(ctx, owner, member)
+	if err != nil {
+		switch err {
+		case store.ErrNotFound:
+			errorMessage := response.ErrorResponse{Message: "Try again later"}
+			response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	if cfg.CacheConfig.Enabled {
+		for _, member := range []*models.OrganizationMember{owner, member} {
+			if err := h.cacheStore.OrganizationMembers.Delete(member.UserProfileID, member.OrganizationID); err != nil {
+				logger.ErrLoggerCache(r, err)
+			}
+		}
+	}
+
+	response.SuccessResponseOK(w, "Ownership transferred", transfer)
+}
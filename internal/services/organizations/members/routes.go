@@ -4,12 +4,15 @@ import (
 	"net/http"
 
 	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/config"
 	"github.com/KengoWada/meetup-clone/internal/middleware"
 	"github.com/KengoWada/meetup-clone/internal/store"
 	"github.com/KengoWada/meetup-clone/internal/store/cache"
 	"github.com/go-chi/chi/v5"
 )
 
+var cfg = config.Get()
+
 type Handler struct {
 	store      store.Store
 	cacheStore cache.Store
@@ -22,6 +25,7 @@ func NewHandler(store store.Store, cacheStore cache.Store) *Handler {
 func (h *Handler) RegisterRoutes() http.Handler {
 	mux := chi.NewRouter()
 
+	mux.Get("/", h.getMembers)
 	mux.Post(
 		"/",
 		middleware.HasOrgPermission(
@@ -32,5 +36,95 @@ func (h *Handler) RegisterRoutes() http.Handler {
 		),
 	)
 
+	mux.Get(
+		"/invites",
+		middleware.HasOrgPermission(
+			[]string{internal.MemberAdd},
+			h.store,
+			h.cacheStore,
+			h.getInvites,
+		),
+	)
+	mux.Route("/invites/{inviteID}", func(inviteMux chi.Router) {
+		inviteMux.Use(getInvite(h.store))
+
+		inviteMux.Patch(
+			"/resend",
+			middleware.HasOrgPermission(
+				[]string{internal.MemberAdd},
+				h.store,
+				h.cacheStore,
+				h.resendInvite,
+			),
+		)
+		inviteMux.Delete(
+			"/",
+			middleware.HasOrgPermission(
+				[]string{internal.MemberAdd},
+				h.store,
+				h.cacheStore,
+				h.revokeInvite,
+			),
+		)
+	})
+
+	mux.Post("/join", h.joinOrganization)
+	mux.Post("/leave", h.leaveOrganization)
+	mux.Post("/transfer-ownership", h.transferOwnership)
+	mux.Get(
+		"/links",
+		middleware.HasOrgPermission(
+			[]string{internal.MemberAdd},
+			h.store,
+			h.cacheStore,
+			h.getJoinLinks,
+		),
+	)
+	mux.Post(
+		"/links",
+		middleware.HasOrgPermission(
+			[]string{internal.MemberAdd},
+			h.store,
+			h.cacheStore,
+			h.createJoinLink,
+		),
+	)
+	mux.Route("/links/{linkID}", func(linkMux chi.Router) {
+		linkMux.Use(getJoinLink(h.store))
+
+		linkMux.Patch(
+			"/disable",
+			middleware.HasOrgPermission(
+				[]string{internal.MemberAdd},
+				h.store,
+				h.cacheStore,
+				h.disableJoinLink,
+			),
+		)
+	})
+
+	mux.Route("/{memberID}", func(memberMux chi.Router) {
+		memberMux.Use(getMember(h.store))
+
+		memberMux.Patch(
+			"/role",
+			middleware.HasOrgPermission(
+				[]string{internal.MemberRoleUpdate},
+				h.store,
+				h.cacheStore,
+				h.updateMemberRole,
+			),
+		)
+		memberMux.Delete(
+			"/",
+			middleware.HasOrgPermission(
+				[]string{internal.MemberRemove},
+				h.store,
+				h.cacheStore,
+				h.removeMember,
+			),
+		)
+	})
+
 	return mux
 }
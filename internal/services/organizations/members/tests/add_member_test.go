@@ -51,7 +51,7 @@ func TestAddMember(t *testing.T) {
 	}
 
 	createTestRole := func(isDeleted bool, orgID int64) *models.Role {
-		permissions := []string{internal.MemberRemove}
+		permissions := []string{internal.MemberAdd}
 		role, err := testutils.CreateTestRole(ctx, appItems.App.Store, isDeleted, orgID, permissions)
 		if err != nil {
 			t.Fatal(err)
@@ -76,7 +76,7 @@ func TestAddMember(t *testing.T) {
 	}
 
 	generateToken := func(ID int64, isValid bool) string {
-		token, err := testutils.GenerateTesAuthToken(appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -104,6 +104,34 @@ func TestAddMember(t *testing.T) {
 		assert.Equal(t, "Invite sent", response.GetMessage())
 	})
 
+	t.Run("should not invite member with a role that has permissions the inviter does not have", func(t *testing.T) {
+		testUser := createTestUser(true)
+		invitedTestUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testRole, err := testutils.CreateTestRole(ctx, appItems.App.Store, false, org.ID, []string{internal.OrgDelete})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		payload := testutils.TestRequestData{
+			"roleId": testRole.ID,
+			"email":  invitedTestUser.Email,
+		}
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+
+		errorMessages, ok := response.GetErrorMessages()
+		if !ok {
+			t.Fatal("failed to convert error messages to map")
+		}
+		assert.Equal(t, "You can not grant a permission you do not have", errorMessages[internal.OrgDelete])
+	})
+
 	t.Run("should not invite user not authenticated", func(t *testing.T) {
 		testUser := createTestUser(true)
 		invitedTestUser := createTestUser(true)
@@ -209,6 +237,15 @@ func TestAddMember(t *testing.T) {
 		}
 		assert.Equal(t, http.StatusCreated, response.StatusCode())
 		assert.Equal(t, "Invite sent", response.GetMessage())
+
+		fields := []string{"email", "org_id"}
+		values := []any{email, testOrg.ID}
+		invite, err := appItems.App.Store.OrganizationInvites.Get(ctx, false, fields, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Nil(t, invite.UserProfileID)
+		assert.Equal(t, testRole.ID, invite.RoleID)
 	})
 
 	t.Run("should not invite user invalid role ID", func(t *testing.T) {
@@ -0,0 +1,189 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateJoinLink(t *testing.T) {
+	testEndpoint := func(orgID int64) string {
+		return fmt.Sprintf("/v1/organizations/%d/members/links", orgID)
+	}
+	testMethod := http.MethodPost
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func(isActive bool, role *models.Role, userID int64) *models.Organization {
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, isActive, role, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org
+	}
+
+	createTestRole := func(isDeleted bool, orgID int64) *models.Role {
+		role, err := testutils.CreateTestRole(ctx, appItems.App.Store, isDeleted, orgID, []string{internal.MemberAdd})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return role
+	}
+
+	generateRole := func(permission string) *models.Role {
+		role := &models.Role{Name: faker.Username(options.WithGenerateUniqueValues(true))}
+
+		switch permission {
+		case "valid":
+			role.Permissions = []string{internal.MemberAdd}
+		case "invalid":
+			role.Permissions = []string{internal.MemberRoleUpdate, internal.MemberRemove}
+		default:
+			role.Permissions = internal.Permissions
+		}
+
+		return role
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	t.Run("should create join link", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testRole := createTestRole(false, org.ID)
+		expiresAt := time.Now().UTC().Add(time.Hour * 24).Format(internal.DateTimeFormat)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		payload := testutils.TestRequestData{"roleId": testRole.ID, "maxUses": 5, "expiresAt": expiresAt}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusCreated, response.StatusCode())
+		assert.Equal(t, "Join link created", response.GetMessage())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+		assert.EqualValues(t, testRole.ID, data["roleId"])
+		assert.EqualValues(t, 5, data["maxUses"])
+		assert.EqualValues(t, 0, data["uses"])
+		assert.NotEmpty(t, data["token"])
+	})
+
+	t.Run("should create join link without limits", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testRole := createTestRole(false, org.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		payload := testutils.TestRequestData{"roleId": testRole.ID}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusCreated, response.StatusCode())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+		assert.Nil(t, data["maxUses"])
+		assert.Nil(t, data["expiresAt"])
+	})
+
+	t.Run("should not create join link invalid payload", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testRole := createTestRole(false, org.ID)
+		pastExpiry := time.Now().UTC().Add(-time.Hour).Format(internal.DateTimeFormat)
+		ownerRole, err := testutils.CreateTestRole(ctx, appItems.App.Store, false, org.ID, []string{internal.MemberAdd, internal.OrgDelete})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		testCases := []struct {
+			name    string
+			payload testutils.TestRequestData
+			field   string
+			message string
+		}{
+			{"missing role", testutils.TestRequestData{}, "roleId", "Field is required"},
+			{"zero max uses", testutils.TestRequestData{"roleId": testRole.ID, "maxUses": 0}, "maxUses", "Max uses should be at least 1"},
+			{"invalid expiry", testutils.TestRequestData{"roleId": testRole.ID, "expiresAt": "tomorrow"}, "expiresAt", "Invalid date time format. yyyy-mm-ddThh:mm:ssZ"},
+			{"past expiry", testutils.TestRequestData{"roleId": testRole.ID, "expiresAt": pastExpiry}, "expiresAt", "Expiry time must be in the future"},
+			{"other organizations role", testutils.TestRequestData{"roleId": createTestRole(false, createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID).ID).ID}, "roleId", "invalid role id"},
+			{"role with permissions the member does not have", testutils.TestRequestData{"roleId": ownerRole.ID}, internal.OrgDelete, "You can not grant a permission you do not have"},
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID), headers, tc.payload)
+				if err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+
+				errorMessages, ok := response.GetErrorMessages()
+				if !ok {
+					t.Fatal("failed to convert response errors to map")
+				}
+				assert.Equal(t, tc.message, errorMessages[tc.field])
+			})
+		}
+	})
+
+	t.Run("should not create join link without permission", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("invalid"), testUser.UserProfile.ID)
+		testRole := createTestRole(false, org.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		payload := testutils.TestRequestData{"roleId": testRole.ID}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+}
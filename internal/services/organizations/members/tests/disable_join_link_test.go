@@ -0,0 +1,175 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisableJoinLink(t *testing.T) {
+	testEndpoint := func(orgID int64, linkID any) string {
+		return fmt.Sprintf("/v1/organizations/%d/members/links/%v/disable", orgID, linkID)
+	}
+	testMethod := http.MethodPatch
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func(isActive bool, role *models.Role, userID int64) *models.Organization {
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, isActive, role, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org
+	}
+
+	createTestRole := func(isDeleted bool, orgID int64) *models.Role {
+		role, err := testutils.CreateTestRole(ctx, appItems.App.Store, isDeleted, orgID, []string{internal.MemberAdd})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return role
+	}
+
+	generateRole := func(permission string) *models.Role {
+		role := &models.Role{Name: faker.Username(options.WithGenerateUniqueValues(true))}
+
+		switch permission {
+		case "valid":
+			role.Permissions = []string{internal.MemberAdd}
+		case "invalid":
+			role.Permissions = []string{internal.MemberRoleUpdate, internal.MemberRemove}
+		default:
+			role.Permissions = internal.Permissions
+		}
+
+		return role
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	createJoinLink := func(orgID int64, admin *models.User, payload testutils.TestRequestData) map[string]any {
+		endpoint := fmt.Sprintf("/v1/organizations/%d/members/links", orgID)
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(admin.ID, true)}
+		response, err := testutils.RunTestRequest(mux, http.MethodPost, endpoint, headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if response.StatusCode() != http.StatusCreated {
+			t.Fatalf("failed to create join link: %s", response.GetMessage())
+		}
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		return data
+	}
+
+	t.Run("should disable join link", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		link := createJoinLink(org.ID, testUser, testutils.TestRequestData{"roleId": createTestRole(false, org.ID).ID})
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, link["id"]), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "Join link disabled", response.GetMessage())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+		assert.NotNil(t, data["disabledAt"])
+
+		response, err = testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, link["id"]), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Join link is already disabled", response.GetMessage())
+	})
+
+	t.Run("should not disable another organizations join link", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		otherOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		link := createJoinLink(otherOrg.ID, testUser, testutils.TestRequestData{"roleId": createTestRole(false, otherOrg.ID).ID})
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, link["id"]), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+
+	t.Run("should not disable join link without permission", func(t *testing.T) {
+		testUser := createTestUser(true)
+		otherUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		link := createJoinLink(org.ID, testUser, testutils.TestRequestData{"roleId": createTestRole(false, org.ID).ID})
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(otherUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, link["id"]), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+
+	t.Run("should not disable join link invalid link id", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, "abc"), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invalid join link ID", response.GetMessage())
+	})
+}
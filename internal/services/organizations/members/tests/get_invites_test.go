@@ -0,0 +1,161 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOrganizationInvites(t *testing.T) {
+	testEndpoint := func(orgID int64) string {
+		return fmt.Sprintf("/v1/organizations/%d/members/invites", orgID)
+	}
+	testMethod := http.MethodGet
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func(isActive bool, role *models.Role, userID int64) *models.Organization {
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, isActive, role, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org
+	}
+
+	createTestInvite := func(isExpired bool, orgID, userID int64) *models.OrganizationInvite {
+		role, err := testutils.CreateTestRole(ctx, appItems.App.Store, false, orgID, []string{internal.EventCreate})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		invite, err := testutils.CreateTestOrganizationInvite(ctx, appItems.App.Store, isExpired, orgID, role.ID, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return invite
+	}
+
+	generateRole := func(permission string) *models.Role {
+		role := &models.Role{Name: faker.Username(options.WithGenerateUniqueValues(true))}
+
+		switch permission {
+		case "valid":
+			role.Permissions = []string{internal.MemberAdd}
+		case "invalid":
+			role.Permissions = []string{internal.MemberRoleUpdate, internal.MemberRemove}
+		default:
+			role.Permissions = internal.Permissions
+		}
+
+		return role
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	t.Run("should get organization invites", func(t *testing.T) {
+		testUser := createTestUser(true)
+		invitedUser := createTestUser(true)
+		expiredUser := createTestUser(true)
+		declinedUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		otherOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+
+		expiredInvite := createTestInvite(true, org.ID, expiredUser.UserProfile.ID)
+		invite := createTestInvite(false, org.ID, invitedUser.UserProfile.ID)
+		createTestInvite(false, otherOrg.ID, invitedUser.UserProfile.ID)
+		declinedInvite := createTestInvite(false, org.ID, declinedUser.UserProfile.ID)
+		if err := appItems.App.Store.OrganizationInvites.Decline(ctx, declinedInvite); err != nil {
+			t.Fatal(err)
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		invites, ok := data["invites"].([]any)
+		if !ok {
+			t.Fatal("failed to convert invites to slice")
+		}
+		assert.Len(t, invites, 2)
+
+		var inviteIDs []int64
+		for _, item := range invites {
+			inviteData, ok := item.(map[string]any)
+			if !ok {
+				t.Fatal("failed to convert invite to map")
+			}
+
+			inviteIDs = append(inviteIDs, int64(inviteData["id"].(float64)))
+		}
+		assert.ElementsMatch(t, []int64{invite.ID, expiredInvite.ID}, inviteIDs)
+	})
+
+	t.Run("should not get organization invites without permission", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("invalid"), testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+
+	t.Run("should not get organization invites not authenticated", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID), nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+		assert.Equal(t, "unauthorized", response.GetMessage())
+	})
+}
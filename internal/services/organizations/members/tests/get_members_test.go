@@ -0,0 +1,279 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOrganizationMembers(t *testing.T) {
+	testEndpoint := func(orgID int64, query string) string {
+		return fmt.Sprintf("/v1/organizations/%d/members?%s", orgID, query)
+	}
+	testMethod := http.MethodGet
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func(isActive bool, role *models.Role, userID int64) *models.Organization {
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, isActive, role, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org
+	}
+
+	generateRole := func(permission string) *models.Role {
+		role := &models.Role{Name: faker.Username(options.WithGenerateUniqueValues(true))}
+
+		switch permission {
+		case "valid":
+			role.Permissions = []string{internal.MemberAdd}
+		case "invalid":
+			role.Permissions = []string{internal.MemberRoleUpdate, internal.MemberRemove}
+		default:
+			role.Permissions = internal.Permissions
+		}
+
+		return role
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	addTestMember := func(orgID, roleID int64) *models.User {
+		user := createTestUser(true)
+		member := &models.OrganizationMember{OrganizationID: orgID, UserProfileID: user.UserProfile.ID, RoleID: roleID}
+		if err := appItems.App.Store.OrganizationMembers.Create(ctx, member); err != nil {
+			t.Fatal(err)
+		}
+
+		return user
+	}
+
+	createTestRole := func(orgID int64) *models.Role {
+		role, err := testutils.CreateTestRole(ctx, appItems.App.Store, false, orgID, []string{internal.EventCreate})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return role
+	}
+
+	getMembers := func(orgID int64, user *models.User, query string) []map[string]any {
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(user.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(orgID, query), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		items, ok := data["members"].([]any)
+		if !ok {
+			t.Fatal("failed to convert members to slice")
+		}
+
+		var members []map[string]any
+		for _, item := range items {
+			member, ok := item.(map[string]any)
+			if !ok {
+				t.Fatal("failed to convert member to map")
+			}
+			members = append(members, member)
+		}
+
+		return members
+	}
+
+	t.Run("should get organization members", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testRole := createTestRole(org.ID)
+		member := addTestMember(org.ID, testRole.ID)
+
+		members := getMembers(org.ID, testUser, "")
+		assert.Len(t, members, 2)
+		assert.Equal(t, testUser.Email, members[0]["email"])
+		assert.Equal(t, true, members[0]["isOwner"])
+		assert.Equal(t, false, members[1]["isOwner"])
+		assert.Equal(t, member.UserProfile.Username, members[1]["username"])
+		assert.Equal(t, testRole.Name, members[1]["roleName"])
+		assert.Equal(t, float64(testRole.ID), members[1]["roleId"])
+		assert.NotEmpty(t, members[1]["joinedAt"])
+
+		members = getMembers(org.ID, member, "")
+		assert.Len(t, members, 2)
+		assert.Equal(t, member.UserProfile.Username, members[1]["username"])
+		assert.Equal(t, testRole.Name, members[1]["roleName"])
+		for _, member := range members {
+			assert.NotContains(t, member, "email")
+			assert.NotContains(t, member, "roleId")
+			assert.NotContains(t, member, "id")
+		}
+	})
+
+	t.Run("should filter organization members", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testRole := createTestRole(org.ID)
+		member := addTestMember(org.ID, testRole.ID)
+		otherMember := addTestMember(org.ID, createTestRole(org.ID).ID)
+
+		members := getMembers(org.ID, testUser, fmt.Sprintf("role_id=%d", testRole.ID))
+		assert.Len(t, members, 1)
+		assert.Equal(t, member.UserProfile.Username, members[0]["username"])
+
+		prefix := strings.ToUpper(otherMember.UserProfile.Username)
+		members = getMembers(org.ID, testUser, "username="+prefix)
+		assert.Len(t, members, 1)
+		assert.Equal(t, otherMember.UserProfile.Username, members[0]["username"])
+	})
+
+	t.Run("should sort and paginate organization members", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testRole := createTestRole(org.ID)
+		for range 4 {
+			addTestMember(org.ID, testRole.ID)
+		}
+
+		for _, sort := range []string{"joinedAt", "-joinedAt", "username", "-username"} {
+			expected := getMembers(org.ID, testUser, "sort="+sort)
+			assert.Len(t, expected, 5)
+
+			var members []any
+			query := "limit=2&sort=" + sort
+			for {
+				headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+				response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, query), headers, nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, http.StatusOK, response.StatusCode())
+
+				data, ok := response.GetData()
+				if !ok {
+					t.Fatal("failed to convert response data to map")
+				}
+				members = append(members, data["members"].([]any)...)
+
+				cursor, ok := data["nextCursor"].(string)
+				if !ok {
+					break
+				}
+				query = fmt.Sprintf("limit=2&sort=%s&cursor=%s", sort, cursor)
+			}
+
+			assert.Len(t, members, len(expected))
+			for index, member := range members {
+				assert.Equal(t, expected[index]["id"], member.(map[string]any)["id"])
+			}
+
+			first := strings.ToLower(expected[0]["username"].(string))
+			last := strings.ToLower(expected[4]["username"].(string))
+			switch sort {
+			case "joinedAt":
+				assert.Equal(t, testUser.UserProfile.Username, expected[0]["username"])
+			case "-joinedAt":
+				assert.Equal(t, testUser.UserProfile.Username, expected[4]["username"])
+			case "username":
+				assert.Less(t, first, last)
+			case "-username":
+				assert.Greater(t, first, last)
+			}
+		}
+	})
+
+	t.Run("should not get organization members invalid query", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+
+		testCases := []struct {
+			name    string
+			query   string
+			message string
+		}{
+			{"invalid role id", "role_id=abc", "Invalid role ID"},
+			{"invalid sort", "sort=email", "Sort should be one of joinedAt, -joinedAt, username or -username"},
+			{"invalid limit", "limit=101", "Limit should be a number between 1 and 100"},
+			{"invalid cursor", "cursor=abc", "Invalid cursor"},
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, tc.query), headers, nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+				assert.Equal(t, tc.message, response.GetMessage())
+			})
+		}
+	})
+
+	t.Run("should not get organization members not a member", func(t *testing.T) {
+		testUser := createTestUser(true)
+		otherUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(otherUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, ""), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+
+	t.Run("should not get organization members not authenticated", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, ""), nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+		assert.Equal(t, "unauthorized", response.GetMessage())
+	})
+}
@@ -0,0 +1,252 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJoinOrganization(t *testing.T) {
+	testEndpoint := func(orgID int64) string {
+		return fmt.Sprintf("/v1/organizations/%d/members/join", orgID)
+	}
+	testMethod := http.MethodPost
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func(isActive bool, role *models.Role, userID int64) *models.Organization {
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, isActive, role, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org
+	}
+
+	createTestRole := func(isDeleted bool, orgID int64) *models.Role {
+		role, err := testutils.CreateTestRole(ctx, appItems.App.Store, isDeleted, orgID, []string{internal.MemberAdd})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return role
+	}
+
+	generateRole := func(permission string) *models.Role {
+		role := &models.Role{Name: faker.Username(options.WithGenerateUniqueValues(true))}
+
+		switch permission {
+		case "valid":
+			role.Permissions = []string{internal.MemberAdd}
+		case "invalid":
+			role.Permissions = []string{internal.MemberRoleUpdate, internal.MemberRemove}
+		default:
+			role.Permissions = internal.Permissions
+		}
+
+		return role
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	createJoinLink := func(orgID int64, admin *models.User, payload testutils.TestRequestData) map[string]any {
+		endpoint := fmt.Sprintf("/v1/organizations/%d/members/links", orgID)
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(admin.ID, true)}
+		response, err := testutils.RunTestRequest(mux, http.MethodPost, endpoint, headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if response.StatusCode() != http.StatusCreated {
+			t.Fatalf("failed to create join link: %s", response.GetMessage())
+		}
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		return data
+	}
+
+	joinOrganization := func(orgID int64, user *models.User, token any) *testutils.TestRequestResponse {
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(user.ID, true)}
+		payload := testutils.TestRequestData{"token": token}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(orgID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return response
+	}
+
+	t.Run("should join organization", func(t *testing.T) {
+		testUser := createTestUser(true)
+		joiningUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testRole := createTestRole(false, org.ID)
+		expiresAt := time.Now().UTC().Add(time.Hour).Format(internal.DateTimeFormat)
+		link := createJoinLink(org.ID, testUser, testutils.TestRequestData{"roleId": testRole.ID, "expiresAt": expiresAt})
+
+		response := joinOrganization(org.ID, joiningUser, link["token"])
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "Joined organization", response.GetMessage())
+
+		fields := []string{"user_id", "org_id"}
+		values := []any{joiningUser.UserProfile.ID, org.ID}
+		member, err := appItems.App.Store.OrganizationMembers.Get(ctx, false, fields, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, testRole.ID, member.RoleID)
+
+		fields = []string{"id"}
+		values = []any{int64(link["id"].(float64))}
+		joinLink, err := appItems.App.Store.OrganizationJoinLinks.Get(ctx, false, fields, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, 1, joinLink.Uses)
+	})
+
+	t.Run("should not join organization when link is used up", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		link := createJoinLink(org.ID, testUser, testutils.TestRequestData{"roleId": createTestRole(false, org.ID).ID, "maxUses": 1})
+
+		response := joinOrganization(org.ID, createTestUser(true), link["token"])
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		response = joinOrganization(org.ID, createTestUser(true), link["token"])
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Join link has reached its maximum number of uses", response.GetMessage())
+	})
+
+	t.Run("should not join organization when link is disabled", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		link := createJoinLink(org.ID, testUser, testutils.TestRequestData{"roleId": createTestRole(false, org.ID).ID})
+
+		fields := []string{"id"}
+		values := []any{int64(link["id"].(float64))}
+		joinLink, err := appItems.App.Store.OrganizationJoinLinks.Get(ctx, false, fields, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := appItems.App.Store.OrganizationJoinLinks.Disable(ctx, joinLink); err != nil {
+			t.Fatal(err)
+		}
+
+		response := joinOrganization(org.ID, createTestUser(true), link["token"])
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Join link has been disabled", response.GetMessage())
+	})
+
+	t.Run("should not join organization when role is deleted", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testRole := createTestRole(false, org.ID)
+		link := createJoinLink(org.ID, testUser, testutils.TestRequestData{"roleId": testRole.ID})
+
+		if err := appItems.App.Store.Roles.SoftDelete(ctx, testRole); err != nil {
+			t.Fatal(err)
+		}
+
+		response := joinOrganization(org.ID, createTestUser(true), link["token"])
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Join link is no longer valid", response.GetMessage())
+	})
+
+	t.Run("should not join organization already a member", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		link := createJoinLink(org.ID, testUser, testutils.TestRequestData{"roleId": createTestRole(false, org.ID).ID})
+
+		response := joinOrganization(org.ID, testUser, link["token"])
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "You are already a member of this organization", response.GetMessage())
+	})
+
+	t.Run("should not add a member twice", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		link := createJoinLink(org.ID, testUser, testutils.TestRequestData{"roleId": createTestRole(false, org.ID).ID})
+
+		fields := []string{"id"}
+		values := []any{int64(link["id"].(float64))}
+		joinLink, err := appItems.App.Store.OrganizationJoinLinks.Get(ctx, false, fields, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Joining skips the membership check the handler does first, as two
+		// concurrent requests would.
+		member := &models.OrganizationMember{UserProfileID: testUser.UserProfile.ID}
+		err = appItems.App.Store.OrganizationJoinLinks.Join(ctx, joinLink, member)
+		assert.ErrorIs(t, err, store.ErrDuplicateMember)
+	})
+
+	t.Run("should not join organization with invalid token", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		otherOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		otherLink := createJoinLink(otherOrg.ID, testUser, testutils.TestRequestData{"roleId": createTestRole(false, otherOrg.ID).ID})
+
+		for _, token := range []any{"invalid-token", otherLink["token"]} {
+			response := joinOrganization(org.ID, createTestUser(true), token)
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+			assert.Equal(t, "Join link is invalid", response.GetMessage())
+		}
+	})
+
+	t.Run("should not join organization not authenticated", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		link := createJoinLink(org.ID, testUser, testutils.TestRequestData{"roleId": createTestRole(false, org.ID).ID})
+
+		payload := testutils.TestRequestData{"token": link["token"]}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID), nil, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+		assert.Equal(t, "unauthorized", response.GetMessage())
+	})
+}
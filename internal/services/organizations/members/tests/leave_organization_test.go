@@ -0,0 +1,161 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeaveOrganization(t *testing.T) {
+	testEndpoint := func(orgID int64) string {
+		return fmt.Sprintf("/v1/organizations/%d/members/leave", orgID)
+	}
+	testMethod := http.MethodPost
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func(isActive bool, role *models.Role, userID int64) *models.Organization {
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, isActive, role, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org
+	}
+
+	addTestMember := func(orgID int64) (*models.User, *models.OrganizationMember) {
+		role, err := testutils.CreateTestRole(ctx, appItems.App.Store, false, orgID, []string{internal.EventCreate})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user := createTestUser(true)
+		member, err := testutils.CreateTestOrganizationMember(ctx, appItems.App.Store, orgID, role.ID, user.UserProfile.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return user, member
+	}
+
+	getOwner := func(orgID, userID int64) *models.OrganizationMember {
+		fields := []string{"user_id", "org_id"}
+		values := []any{userID, orgID}
+		member, err := appItems.App.Store.OrganizationMembers.Get(ctx, false, fields, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return member
+	}
+
+	generateRole := func() *models.Role {
+		return &models.Role{Name: faker.Username(options.WithGenerateUniqueValues(true)), Permissions: internal.Permissions}
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	t.Run("should leave organization", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole(), testUser.UserProfile.ID)
+		memberUser, member := addTestMember(org.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(memberUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "Left organization", response.GetMessage())
+
+		fields := []string{"id"}
+		values := []any{member.ID}
+		_, err = appItems.App.Store.OrganizationMembers.Get(ctx, false, fields, values)
+		assert.Equal(t, store.ErrNotFound, err)
+
+		response, err = testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+
+	t.Run("should not leave organization last owner", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole(), testUser.UserProfile.ID)
+		addTestMember(org.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Transfer ownership to another member before leaving the organization", response.GetMessage())
+
+		owner := getOwner(org.ID, testUser.UserProfile.ID)
+		assert.True(t, owner.IsOwner)
+	})
+
+	t.Run("should not leave organization not a member", func(t *testing.T) {
+		testUser := createTestUser(true)
+		otherUser := createTestUser(true)
+		org := createTestOrg(true, generateRole(), testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(otherUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+
+	t.Run("should not leave organization not authenticated", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole(), testUser.UserProfile.ID)
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID), nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+		assert.Equal(t, "unauthorized", response.GetMessage())
+	})
+}
@@ -0,0 +1,220 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoveMember(t *testing.T) {
+	testEndpoint := func(orgID int64, memberID any) string {
+		return fmt.Sprintf("/v1/organizations/%d/members/%v", orgID, memberID)
+	}
+	testMethod := http.MethodDelete
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func(isActive bool, role *models.Role, userID int64) *models.Organization {
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, isActive, role, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org
+	}
+
+	createTestRole := func(orgID int64, permissions []string) *models.Role {
+		role, err := testutils.CreateTestRole(ctx, appItems.App.Store, false, orgID, permissions)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return role
+	}
+
+	addTestMember := func(orgID, roleID int64) (*models.User, *models.OrganizationMember) {
+		user := createTestUser(true)
+		member := &models.OrganizationMember{OrganizationID: orgID, UserProfileID: user.UserProfile.ID, RoleID: roleID}
+		if err := appItems.App.Store.OrganizationMembers.Create(ctx, member); err != nil {
+			t.Fatal(err)
+		}
+
+		return user, member
+	}
+
+	generateRole := func(permission string) *models.Role {
+		role := &models.Role{Name: faker.Username(options.WithGenerateUniqueValues(true))}
+
+		switch permission {
+		case "valid":
+			role.Permissions = []string{internal.MemberRemove}
+		case "invalid":
+			role.Permissions = []string{internal.MemberAdd, internal.MemberRoleUpdate}
+		default:
+			role.Permissions = internal.Permissions
+		}
+
+		return role
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	t.Run("should remove member", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		memberUser, member := addTestMember(org.ID, createTestRole(org.ID, []string{internal.MemberAdd}).ID)
+
+		invitesEndpoint := fmt.Sprintf("/v1/organizations/%d/members/invites", org.ID)
+		memberHeaders := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(memberUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, http.MethodGet, invitesEndpoint, memberHeaders, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err = testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, member.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "Member removed", response.GetMessage())
+
+		fields := []string{"id"}
+		values := []any{member.ID}
+		_, err = appItems.App.Store.OrganizationMembers.Get(ctx, false, fields, values)
+		assert.Equal(t, store.ErrNotFound, err)
+
+		response, err = testutils.RunTestRequest(mux, http.MethodGet, invitesEndpoint, memberHeaders, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+
+		response, err = testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, member.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+
+	t.Run("should not remove yourself", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+
+		fields := []string{"user_id", "org_id"}
+		values := []any{testUser.UserProfile.ID, org.ID}
+		member, err := appItems.App.Store.OrganizationMembers.Get(ctx, false, fields, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, member.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "You can not remove yourself from the organization", response.GetMessage())
+	})
+
+	t.Run("should not remove the owner", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		memberUser, _ := addTestMember(org.ID, createTestRole(org.ID, []string{internal.MemberRemove}).ID)
+
+		fields := []string{"user_id", "org_id"}
+		values := []any{testUser.UserProfile.ID, org.ID}
+		owner, err := appItems.App.Store.OrganizationMembers.Get(ctx, false, fields, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(memberUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, owner.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "The organization's owner can not be removed", response.GetMessage())
+	})
+
+	t.Run("should not remove another organizations member", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		otherOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		_, member := addTestMember(otherOrg.ID, createTestRole(otherOrg.ID, []string{internal.EventCreate}).ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, member.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+
+	t.Run("should not remove member without permission", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("invalid"), testUser.UserProfile.ID)
+		_, member := addTestMember(org.ID, createTestRole(org.ID, []string{internal.EventCreate}).ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, member.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+
+	t.Run("should not remove member invalid member id", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, "abc"), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invalid member ID", response.GetMessage())
+	})
+}
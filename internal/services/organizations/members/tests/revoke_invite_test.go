@@ -0,0 +1,160 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevokeOrganizationInvite(t *testing.T) {
+	testEndpoint := func(orgID, inviteID int64) string {
+		return fmt.Sprintf("/v1/organizations/%d/members/invites/%d", orgID, inviteID)
+	}
+	testMethod := http.MethodDelete
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func(isActive bool, role *models.Role, userID int64) *models.Organization {
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, isActive, role, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org
+	}
+
+	createTestInvite := func(isExpired bool, orgID, userID int64) *models.OrganizationInvite {
+		role, err := testutils.CreateTestRole(ctx, appItems.App.Store, false, orgID, []string{internal.EventCreate})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		invite, err := testutils.CreateTestOrganizationInvite(ctx, appItems.App.Store, isExpired, orgID, role.ID, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return invite
+	}
+
+	generateRole := func(permission string) *models.Role {
+		role := &models.Role{Name: faker.Username(options.WithGenerateUniqueValues(true))}
+
+		switch permission {
+		case "valid":
+			role.Permissions = []string{internal.MemberAdd}
+		case "invalid":
+			role.Permissions = []string{internal.MemberRoleUpdate, internal.MemberRemove}
+		default:
+			role.Permissions = internal.Permissions
+		}
+
+		return role
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	t.Run("should revoke invite", func(t *testing.T) {
+		testUser := createTestUser(true)
+		invitedUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		invite := createTestInvite(false, org.ID, invitedUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, invite.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "Invite revoked", response.GetMessage())
+
+		acceptEndpoint := fmt.Sprintf("/v1/profiles/invites/%d/accept", invite.ID)
+		headers = testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(invitedUser.ID, true)}
+		response, err = testutils.RunTestRequest(mux, http.MethodPatch, acceptEndpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+	})
+
+	t.Run("should not revoke invite already responded to", func(t *testing.T) {
+		testUser := createTestUser(true)
+		invitedUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		invite := createTestInvite(false, org.ID, invitedUser.UserProfile.ID)
+		if err := appItems.App.Store.OrganizationInvites.Accept(ctx, invite, &models.OrganizationMember{}); err != nil {
+			t.Fatal(err)
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, invite.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invite has already been responded to", response.GetMessage())
+	})
+
+	t.Run("should not revoke invite without permission", func(t *testing.T) {
+		testUser := createTestUser(true)
+		invitedUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("invalid"), testUser.UserProfile.ID)
+		invite := createTestInvite(false, org.ID, invitedUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, invite.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+
+	t.Run("should not revoke invite invalid invite id", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		endpoint := fmt.Sprintf("/v1/organizations/%d/members/invites/abc", org.ID)
+		response, err := testutils.RunTestRequest(mux, testMethod, endpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invalid invite ID", response.GetMessage())
+	})
+}
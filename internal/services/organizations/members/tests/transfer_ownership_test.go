@@ -0,0 +1,189 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransferOwnership(t *testing.T) {
+	testEndpoint := func(orgID int64) string {
+		return fmt.Sprintf("/v1/organizations/%d/members/transfer-ownership", orgID)
+	}
+	testMethod := http.MethodPost
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func(isActive bool, role *models.Role, userID int64) *models.Organization {
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, isActive, role, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org
+	}
+
+	addTestMember := func(orgID int64) (*models.User, *models.OrganizationMember) {
+		role, err := testutils.CreateTestRole(ctx, appItems.App.Store, false, orgID, []string{internal.EventCreate})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user := createTestUser(true)
+		member, err := testutils.CreateTestOrganizationMember(ctx, appItems.App.Store, orgID, role.ID, user.UserProfile.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return user, member
+	}
+
+	getOwner := func(orgID, userID int64) *models.OrganizationMember {
+		fields := []string{"user_id", "org_id"}
+		values := []any{userID, orgID}
+		member, err := appItems.App.Store.OrganizationMembers.Get(ctx, false, fields, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return member
+	}
+
+	generateRole := func() *models.Role {
+		return &models.Role{Name: faker.Username(options.WithGenerateUniqueValues(true)), Permissions: internal.Permissions}
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	t.Run("should transfer ownership", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole(), testUser.UserProfile.ID)
+		memberUser, member := addTestMember(org.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		data := testutils.TestRequestData{"memberId": member.ID}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID), headers, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "Ownership transferred", response.GetMessage())
+
+		responseData, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+		owner := getOwner(org.ID, testUser.UserProfile.ID)
+		assert.Equal(t, float64(owner.ID), responseData["fromMemberId"])
+		assert.Equal(t, float64(member.ID), responseData["toMemberId"])
+		assert.False(t, owner.IsOwner)
+
+		newOwner := getOwner(org.ID, memberUser.UserProfile.ID)
+		assert.True(t, newOwner.IsOwner)
+
+		response, err = testutils.RunTestRequest(mux, http.MethodPost, fmt.Sprintf("/v1/organizations/%d/members/leave", org.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+	})
+
+	t.Run("should not transfer ownership not the owner", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole(), testUser.UserProfile.ID)
+		memberUser, _ := addTestMember(org.ID)
+		_, otherMember := addTestMember(org.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(memberUser.ID, true)}
+		data := testutils.TestRequestData{"memberId": otherMember.ID}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID), headers, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+
+	t.Run("should not transfer ownership invalid member", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole(), testUser.UserProfile.ID)
+		otherOrg := createTestOrg(true, generateRole(), testUser.UserProfile.ID)
+		_, otherMember := addTestMember(otherOrg.ID)
+		owner := getOwner(org.ID, testUser.UserProfile.ID)
+
+		testCases := []struct {
+			name    string
+			data    testutils.TestRequestData
+			message string
+		}{
+			{"missing member", testutils.TestRequestData{}, "Field is required"},
+			{"other organizations member", testutils.TestRequestData{"memberId": otherMember.ID}, "invalid member id"},
+			{"already the owner", testutils.TestRequestData{"memberId": owner.ID}, "Member is already an owner"},
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID), headers, tc.data)
+				if err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+
+				errorMessages, ok := response.GetErrorMessages()
+				if !ok {
+					t.Fatal("failed to convert error messages to map")
+				}
+				assert.Equal(t, tc.message, errorMessages["memberId"])
+			})
+		}
+	})
+
+	t.Run("should not transfer ownership not authenticated", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole(), testUser.UserProfile.ID)
+		_, member := addTestMember(org.ID)
+
+		data := testutils.TestRequestData{"memberId": member.ID}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID), nil, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+		assert.Equal(t, "unauthorized", response.GetMessage())
+	})
+}
@@ -0,0 +1,286 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateMemberRole(t *testing.T) {
+	testEndpoint := func(orgID int64, memberID any) string {
+		return fmt.Sprintf("/v1/organizations/%d/members/%v/role", orgID, memberID)
+	}
+	testMethod := http.MethodPatch
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func(isActive bool, role *models.Role, userID int64) *models.Organization {
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, isActive, role, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org
+	}
+
+	createTestRole := func(orgID int64, permissions []string) *models.Role {
+		role, err := testutils.CreateTestRole(ctx, appItems.App.Store, false, orgID, permissions)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return role
+	}
+
+	addTestMember := func(orgID, roleID int64) (*models.User, *models.OrganizationMember) {
+		user := createTestUser(true)
+		member := &models.OrganizationMember{OrganizationID: orgID, UserProfileID: user.UserProfile.ID, RoleID: roleID}
+		if err := appItems.App.Store.OrganizationMembers.Create(ctx, member); err != nil {
+			t.Fatal(err)
+		}
+
+		return user, member
+	}
+
+	generateRole := func(permission string) *models.Role {
+		role := &models.Role{Name: faker.Username(options.WithGenerateUniqueValues(true))}
+
+		switch permission {
+		case "valid":
+			role.Permissions = []string{internal.MemberRoleUpdate}
+		case "invalid":
+			role.Permissions = []string{internal.MemberAdd, internal.MemberRemove}
+		default:
+			role.Permissions = internal.Permissions
+		}
+
+		return role
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	t.Run("should update member role", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		memberUser, member := addTestMember(org.ID, createTestRole(org.ID, []string{internal.MemberAdd}).ID)
+		newRole := createTestRole(org.ID, []string{internal.MemberRoleUpdate})
+
+		invitesEndpoint := fmt.Sprintf("/v1/organizations/%d/members/invites", org.ID)
+		memberHeaders := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(memberUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, http.MethodGet, invitesEndpoint, memberHeaders, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		data := testutils.TestRequestData{"roleId": newRole.ID}
+		response, err = testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, member.ID), headers, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "Role updated", response.GetMessage())
+
+		fields := []string{"id"}
+		values := []any{member.ID}
+		updatedMember, err := appItems.App.Store.OrganizationMembers.Get(ctx, false, fields, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, newRole.ID, updatedMember.RoleID)
+
+		response, err = testutils.RunTestRequest(mux, http.MethodGet, invitesEndpoint, memberHeaders, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+
+	t.Run("should not update member role invalid role", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		otherOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		_, member := addTestMember(org.ID, createTestRole(org.ID, []string{internal.EventCreate}).ID)
+
+		deletedRole, err := testutils.CreateTestRole(ctx, appItems.App.Store, true, org.ID, []string{internal.EventCreate})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		testCases := []struct {
+			name    string
+			data    testutils.TestRequestData
+			message string
+		}{
+			{"missing role", testutils.TestRequestData{}, "Field is required"},
+			{"deleted role", testutils.TestRequestData{"roleId": deletedRole.ID}, "invalid role id"},
+			{"other organizations role", testutils.TestRequestData{"roleId": createTestRole(otherOrg.ID, []string{internal.EventCreate}).ID}, "invalid role id"},
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, member.ID), headers, tc.data)
+				if err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+
+				errorMessages, ok := response.GetErrorMessages()
+				if !ok {
+					t.Fatal("failed to convert error messages to map")
+				}
+				assert.Equal(t, tc.message, errorMessages["roleId"])
+			})
+		}
+	})
+
+	t.Run("should not update member role to a role with permissions the member does not have", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		_, member := addTestMember(org.ID, createTestRole(org.ID, []string{internal.MemberRoleUpdate}).ID)
+		ownerRole := createTestRole(org.ID, []string{internal.MemberRoleUpdate, internal.OrgDelete, internal.RoleCreate})
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		data := testutils.TestRequestData{"roleId": ownerRole.ID}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, member.ID), headers, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+
+		errorMessages, ok := response.GetErrorMessages()
+		if !ok {
+			t.Fatal("failed to convert error messages to map")
+		}
+		assert.Len(t, errorMessages, 2)
+		assert.Equal(t, "You can not grant a permission you do not have", errorMessages[internal.OrgDelete])
+		assert.Equal(t, "You can not grant a permission you do not have", errorMessages[internal.RoleCreate])
+	})
+
+	t.Run("should not update the owners role", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("all"), testUser.UserProfile.ID)
+		adminUser, _ := addTestMember(org.ID, createTestRole(org.ID, []string{internal.MemberRoleUpdate}).ID)
+
+		fields := []string{"user_id", "org_id"}
+		values := []any{testUser.UserProfile.ID, org.ID}
+		owner, err := appItems.App.Store.OrganizationMembers.Get(ctx, false, fields, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(adminUser.ID, true)}
+		data := testutils.TestRequestData{"roleId": createTestRole(org.ID, []string{internal.MemberRoleUpdate}).ID}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, owner.ID), headers, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+
+		updatedOwner, err := appItems.App.Store.OrganizationMembers.Get(ctx, false, fields, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, owner.RoleID, updatedOwner.RoleID)
+	})
+
+	t.Run("should not update own role", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testRole := createTestRole(org.ID, []string{internal.MemberRoleUpdate})
+		adminUser, admin := addTestMember(org.ID, testRole.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(adminUser.ID, true)}
+		data := testutils.TestRequestData{"roleId": createTestRole(org.ID, []string{internal.MemberRoleUpdate}).ID}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, admin.ID), headers, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+
+	t.Run("should not update member role of another organizations member", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		otherOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		_, member := addTestMember(otherOrg.ID, createTestRole(otherOrg.ID, []string{internal.EventCreate}).ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		data := testutils.TestRequestData{"roleId": createTestRole(org.ID, []string{internal.EventCreate}).ID}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, member.ID), headers, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+
+	t.Run("should not update member role without permission", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("invalid"), testUser.UserProfile.ID)
+		testRole := createTestRole(org.ID, []string{internal.EventCreate})
+		_, member := addTestMember(org.ID, testRole.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		data := testutils.TestRequestData{"roleId": testRole.ID}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, member.ID), headers, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+
+	t.Run("should not update member role invalid member id", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		data := testutils.TestRequestData{"roleId": createTestRole(org.ID, []string{internal.EventCreate}).ID}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID, "abc"), headers, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invalid member ID", response.GetMessage())
+	})
+}
@@ -0,0 +1,158 @@
+package members
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/logger"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/utils"
+	"github.com/KengoWada/meetup-clone/internal/validate"
+)
+
+type memberRolePayload struct {
+	RoleID int64 `json:"roleId" validate:"required"`
+}
+
+// UpdateMemberRole godoc
+//
+//	@Summary		Change a member's role
+//	@Description	Change the role of a member of an organization. The member's new permissions apply right away. The owner's role and your own role can not be changed
+//	@Tags			members
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID		path		int							true	"orgID the member belongs to"
+//	@Param			memberID	path		int							true	"memberID whose role to change"
+//	@Param			payload		body		memberRolePayload			true	"member role payload"
+//	@Success		200			{object}	models.OrganizationMember	"role successfully changed"
+//	@Failure		400			{object}	response.DocsErrorResponse
+//	@Failure		401			{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403			{object}	response.DocsErrorResponseForbidden
+//	@Failure		500			{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/members/{memberID}/role [patch]
+func (h *Handler) updateMemberRole(w http.ResponseWriter, r *http.Request) {
+	var payload memberRolePayload
+	if err := utils.ReadJSON(w, r, &payload); err != nil {
+		response.ErrorResponseInvalidJSON(w, r, err)
+		return
+	}
+
+	if errorMessages, err := validate.ValidatePayload(payload, memberRolePayloadErrors); err != nil {
+		switch err {
+		case validate.ErrFailedValidation:
+			errorResponse := response.NewValidationErrorResponse(errorMessages)
+			response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+	member, _ := ctx.Value(internal.MemberCtx).(*models.OrganizationMember)
+
+	if member.UserProfileID == user.UserProfile.ID {
+		err := errors.New("member tried to change their own role")
+		response.ErrorResponseForbidden(w, r, err)
+		return
+	}
+
+	if member.IsOwner {
+		err := errors.New("member tried to change the role of an owner")
+		response.ErrorResponseForbidden(w, r, err)
+		return
+	}
+
+	errorMessages, err := validateAssignableRole(r, h.store, h.cacheStore, payload.RoleID, member.OrganizationID)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	if errorMessages != nil {
+		err := errors.New("role can not be assigned")
+		errorResponse := response.NewValidationErrorResponse(errorMessages)
+		response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		return
+	}
+
+	member.RoleID = payload.RoleID
+	if err := h.store.OrganizationMembers.UpdateRole(ctx, member); err != nil {
+		switch err {
+		case store.ErrNotFound:
+			errorMessage := response.ErrorResponse{Message: "Try again later"}
+			response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	if cfg.CacheConfig.Enabled {
+		if err := h.cacheStore.OrganizationMembers.Delete(member.UserProfileID, member.OrganizationID); err != nil {
+			logger.ErrLoggerCache(r, err)
+		}
+	}
+
+	response.SuccessResponseOK(w, "Role updated", member)
+}
+
+// RemoveMember godoc
+//
+//	@Summary		Remove a member from an organization
+//	@Description	Remove a member from an organization. The member loses their permissions right away
+//	@Tags			members
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID		path		int									true	"orgID the member belongs to"
+//	@Param			memberID	path		int									true	"memberID to remove"
+//	@Success		200			{object}	response.DocsResponseMessageOnly	"member successfully removed"
+//	@Failure		400			{object}	response.DocsErrorResponse
+//	@Failure		401			{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403			{object}	response.DocsErrorResponseForbidden
+//	@Failure		500			{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/members/{memberID} [delete]
+func (h *Handler) removeMember(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+	member, _ := ctx.Value(internal.MemberCtx).(*models.OrganizationMember)
+
+	if member.UserProfileID == user.UserProfile.ID {
+		err := errors.New("member tried to remove themselves")
+		errorMessage := response.ErrorResponse{Message: "You can not remove yourself from the organization"}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	if member.IsOwner {
+		err := errors.New("member tried to remove an owner")
+		errorMessage := response.ErrorResponse{Message: "The organization's owner can not be removed"}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	if err := h.store.OrganizationMembers.SoftDelete(ctx, member); err != nil {
+		switch err {
+		case store.ErrNotFound:
+			errorMessage := response.ErrorResponse{Message: "Try again later"}
+			response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	if cfg.CacheConfig.Enabled {
+		if err := h.cacheStore.OrganizationMembers.Delete(member.UserProfileID, member.OrganizationID); err != nil {
+			logger.ErrLoggerCache(r, err)
+		}
+	}
+
+	response.SuccessResponseOK(w, "Member removed", nil)
+}
@@ -0,0 +1,73 @@
+package members
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/middleware"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/store/cache"
+	"github.com/KengoWada/meetup-clone/internal/validate"
+)
+
+var (
+	joinLinkPayloadErrors = validate.FieldErrorMessages{
+		"maxUses": validate.TagErrorMessages{
+			"min": "Max uses should be at least 1",
+		},
+		"expiresAt": validate.TagErrorsDateTime,
+	}
+
+	joinOrganizationPayloadErrors = validate.FieldErrorMessages{
+		"token": validate.TagErrorMessages{},
+	}
+
+	memberRolePayloadErrors = validate.FieldErrorMessages{
+		"roleId": validate.TagErrorMessages{},
+	}
+
+	transferOwnershipPayloadErrors = validate.FieldErrorMessages{
+		"memberId": validate.TagErrorMessages{},
+	}
+)
+
+// validateJoinLinkExpiry checks that a join link expires in the future.
+// Links without an expiry time are valid. The expiry time is expected to
+// have already passed the `is_datetime` validation.
+func validateJoinLinkExpiry(expiresAt *string) response.ErrorsResponse {
+	if expiresAt == nil {
+		return nil
+	}
+
+	expiry, _ := time.Parse(internal.DateTimeFormat, *expiresAt)
+	if !expiry.After(time.Now()) {
+		return response.ErrorsResponse{"expiresAt": "Expiry time must be in the future"}
+	}
+
+	return nil
+}
+
+// validateAssignableRole checks that the role belongs to the organization
+// and that the user making the request holds every permission the role
+// grants, so that members can not give out more access than they have.
+func validateAssignableRole(r *http.Request, appStore store.Store, cacheStore cache.Store, roleID, orgID int64) (response.ErrorsResponse, error) {
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+
+	fields := []string{"id", "org_id"}
+	values := []any{roleID, orgID}
+	role, err := appStore.Roles.Get(ctx, false, fields, values)
+	if err != nil {
+		switch err {
+		case store.ErrNotFound:
+			return response.ErrorsResponse{"roleId": "invalid role id"}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return middleware.ValidateGrantablePermissions(ctx, r, appStore, cacheStore, role.Permissions, user.UserProfile.ID, orgID)
+}
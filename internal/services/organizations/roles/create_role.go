@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/middleware"
 	"github.com/KengoWada/meetup-clone/internal/models"
 	"github.com/KengoWada/meetup-clone/internal/services/response"
 	"github.com/KengoWada/meetup-clone/internal/utils"
@@ -55,8 +56,22 @@ func (h *Handler) createRole(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
 	organization := ctx.Value(internal.OrgCtx).(*models.Organization)
 
+	errorMessages, err = middleware.ValidateGrantablePermissions(ctx, r, h.store, h.cacheStore, payload.Permissions, user.UserProfile.ID, organization.ID)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	if errorMessages != nil {
+		err := errors.New("member tried to grant permissions they do not have")
+		errorResponse := response.NewValidationErrorResponse(errorMessages)
+		response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		return
+	}
+
 	nameExists, err := roleNameExists(ctx, h.store, string(payload.Name), organization.ID, 0)
 	if err != nil {
 		response.ErrorResponseInternalServerErr(w, r, err)
@@ -66,7 +66,7 @@ func TestCreateOrgRole(t *testing.T) {
 	}
 
 	generateToken := func(ID int64, isValid bool) string {
-		token, err := testutils.GenerateTesAuthToken(appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -194,4 +194,31 @@ func TestCreateOrgRole(t *testing.T) {
 		}
 		assert.Equal(t, "name already exists", errMessages["name"])
 	})
+
+	t.Run("should not create org role with permissions the member does not have", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		payload := testutils.TestRequestData{
+			"name":        faker.Username(options.WithGenerateUniqueValues(true)),
+			"description": "Simple Description",
+			"permissions": []string{internal.RoleCreate, internal.OrgDelete, internal.MemberAdd},
+		}
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(org.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invalid request body", response.GetMessage())
+
+		errMessages, ok := response.GetErrorMessages()
+		if !ok {
+			t.Fatal("failed to convert response errors to map")
+		}
+		assert.Len(t, errMessages, 2)
+		assert.Equal(t, "You can not grant a permission you do not have", errMessages[internal.OrgDelete])
+		assert.Equal(t, "You can not grant a permission you do not have", errMessages[internal.MemberAdd])
+	})
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/KengoWada/meetup-clone/internal"
 	"github.com/KengoWada/meetup-clone/internal/app"
 	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/store"
 	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
 	"github.com/go-faker/faker/v4"
 	"github.com/go-faker/faker/v4/pkg/options"
@@ -86,7 +87,7 @@ func TestDeleteRole(t *testing.T) {
 	}
 
 	generateToken := func(ID int64, isValid bool) string {
-		token, err := testutils.GenerateTesAuthToken(appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -136,4 +137,166 @@ func TestDeleteRole(t *testing.T) {
 		assert.Equal(t, http.StatusForbidden, response.StatusCode())
 		assert.Equal(t, "forbidden", response.GetMessage())
 	})
+
+	t.Run("should delete role and reassign its members", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("all"), testUser.UserProfile.ID)
+		testRole := createTestRole(false, testOrg.ID)
+		replacementRole := createTestRole(false, testOrg.ID)
+
+		var members []*models.OrganizationMember
+		for range 2 {
+			user := createTestUser(true)
+			member, err := testutils.CreateTestOrganizationMember(ctx, appItems.App.Store, testOrg.ID, testRole.ID, user.UserProfile.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			members = append(members, member)
+		}
+
+		endpoint := fmt.Sprintf("%s?replacement_role_id=%d", testEndpoint(testOrg.ID, testRole.ID), replacementRole.ID)
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, endpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "Done", response.GetMessage())
+
+		for _, member := range members {
+			fields := []string{"id"}
+			values := []any{member.ID}
+			member, err := appItems.App.Store.OrganizationMembers.Get(ctx, false, fields, values)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, replacementRole.ID, member.RoleID)
+		}
+
+		fields := []string{"id"}
+		values := []any{testRole.ID}
+		_, err = appItems.App.Store.Roles.Get(ctx, false, fields, values)
+		assert.Equal(t, store.ErrNotFound, err)
+	})
+
+	t.Run("should not delete role with invalid replacement role", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("all"), testUser.UserProfile.ID)
+		otherOrg := createTestOrg(true, generateRole("all"), testUser.UserProfile.ID)
+		testRole := createTestRole(false, testOrg.ID)
+		addMemberToOrg(1, testOrg.ID, testRole.ID)
+
+		testCases := []struct {
+			name          string
+			replacementID any
+			message       string
+		}{
+			{"not a number", "abc", "Invalid replacement role ID"},
+			{"same role", testRole.ID, "Replacement role must be a different role"},
+			{"deleted role", createTestRole(true, testOrg.ID).ID, "Invalid replacement role ID"},
+			{"other organizations role", createTestRole(false, otherOrg.ID).ID, "Invalid replacement role ID"},
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				endpoint := fmt.Sprintf("%s?replacement_role_id=%v", testEndpoint(testOrg.ID, testRole.ID), tc.replacementID)
+				response, err := testutils.RunTestRequest(mux, testMethod, endpoint, headers, nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+				assert.Equal(t, tc.message, response.GetMessage())
+			})
+		}
+	})
+
+	t.Run("should not delete role with replacement role that has permissions the member does not have", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testRole := createTestRole(false, testOrg.ID)
+		replacementRole, err := testutils.CreateTestRole(ctx, appItems.App.Store, false, testOrg.ID, []string{internal.RoleDelete, internal.OrgDelete})
+		if err != nil {
+			t.Fatal(err)
+		}
+		addMemberToOrg(1, testOrg.ID, testRole.ID)
+
+		endpoint := fmt.Sprintf("%s?replacement_role_id=%d", testEndpoint(testOrg.ID, testRole.ID), replacementRole.ID)
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, endpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+
+		errorMessages, ok := response.GetErrorMessages()
+		if !ok {
+			t.Fatal("failed to convert error messages to map")
+		}
+		assert.Len(t, errorMessages, 1)
+		assert.Equal(t, "You can not grant a permission you do not have", errorMessages[internal.OrgDelete])
+	})
+
+	t.Run("should not delete the owner's role", func(t *testing.T) {
+		owner := createTestUser(true)
+		ownerRole := generateRole("all")
+		testOrg := createTestOrg(true, ownerRole, owner.UserProfile.ID)
+		replacementRole := createTestRole(false, testOrg.ID)
+
+		testUser := createTestUser(true)
+		testRole, err := testutils.CreateTestRole(ctx, appItems.App.Store, false, testOrg.ID, internal.Permissions)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = testutils.CreateTestOrganizationMember(ctx, appItems.App.Store, testOrg.ID, testRole.ID, testUser.UserProfile.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		endpoint := fmt.Sprintf("%s?replacement_role_id=%d", testEndpoint(testOrg.ID, ownerRole.ID), replacementRole.ID)
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, endpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "You can not delete the owner's role", response.GetMessage())
+
+		fields := []string{"user_id", "org_id"}
+		values := []any{owner.UserProfile.ID, testOrg.ID}
+		member, err := appItems.App.Store.OrganizationMembers.Get(ctx, false, fields, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, ownerRole.ID, member.RoleID)
+	})
+
+	t.Run("should not reassign members to a deleted replacement role", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("all"), testUser.UserProfile.ID)
+		testRole := createTestRole(false, testOrg.ID)
+		replacementRole := createTestRole(false, testOrg.ID)
+
+		user := createTestUser(true)
+		member, err := testutils.CreateTestOrganizationMember(ctx, appItems.App.Store, testOrg.ID, testRole.ID, user.UserProfile.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// The replacement role is deleted after the handler fetched it.
+		if err := appItems.App.Store.Roles.SoftDelete(ctx, replacementRole); err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = appItems.App.Store.Roles.SoftDeleteAndReassign(ctx, testRole, replacementRole.ID)
+		assert.Equal(t, store.ErrNotFound, err)
+
+		fields := []string{"id"}
+		values := []any{member.ID}
+		member, err = appItems.App.Store.OrganizationMembers.Get(ctx, false, fields, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, testRole.ID, member.RoleID)
+	})
 }
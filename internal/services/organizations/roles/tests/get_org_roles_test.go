@@ -85,7 +85,7 @@ func TestGetOrgRoles(t *testing.T) {
 	}
 
 	generateToken := func(ID int64, isValid bool) string {
-		token, err := testutils.GenerateTesAuthToken(appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -70,7 +70,7 @@ func TestGetRolePermission(t *testing.T) {
 	}
 
 	generateToken := func(ID int64, isValid bool) string {
-		token, err := testutils.GenerateTesAuthToken(appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -100,6 +100,7 @@ func TestGetRolePermission(t *testing.T) {
 		}
 
 		assert.ElementsMatch(t, internal.PermissionsMap["events"], permissions["events"])
+		assert.ElementsMatch(t, internal.PermissionsMap["venues"], permissions["venues"])
 		assert.ElementsMatch(t, internal.PermissionsMap["members"], permissions["members"])
 		assert.ElementsMatch(t, internal.PermissionsMap["roles"], permissions["roles"])
 		assert.ElementsMatch(t, internal.PermissionsMap["organizations"], permissions["organizations"])
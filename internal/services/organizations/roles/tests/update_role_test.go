@@ -76,7 +76,7 @@ func TestUpdateRole(t *testing.T) {
 	}
 
 	generateToken := func(ID int64, isValid bool) string {
-		token, err := testutils.GenerateTesAuthToken(appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -94,7 +94,7 @@ func TestUpdateRole(t *testing.T) {
 		payload := testutils.TestRequestData{
 			"name":        newRoleName,
 			"description": newRoleName + " description",
-			"permissions": []string{internal.RoleCreate, internal.RoleDelete},
+			"permissions": []string{internal.RoleUpdate},
 		}
 
 		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testRole.ID), headers, payload)
@@ -202,7 +202,7 @@ func TestUpdateRole(t *testing.T) {
 		payload := testutils.TestRequestData{
 			"name":        testRoleTwo.Name,
 			"description": testRoleTwo.Name + " description",
-			"permissions": []string{internal.RoleCreate},
+			"permissions": []string{internal.RoleUpdate},
 		}
 
 		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testRole.ID), headers, payload)
@@ -219,4 +219,70 @@ func TestUpdateRole(t *testing.T) {
 
 		assert.Equal(t, "name already exists", errorMessages["name"])
 	})
+
+	t.Run("should not update role with permissions the member does not have", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		testRole := createTestRole(false, testOrg.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		newRoleName := faker.Username(options.WithGenerateUniqueValues(true))
+		payload := testutils.TestRequestData{
+			"name":        newRoleName,
+			"description": newRoleName + " description",
+			"permissions": []string{internal.RoleUpdate, internal.OrgDelete},
+		}
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, testRole.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invalid request body", response.GetMessage())
+
+		errorMessages, ok := response.GetErrorMessages()
+		if !ok {
+			t.Fatal("failed to convert response errors to map")
+		}
+		assert.Len(t, errorMessages, 1)
+		assert.Equal(t, "You can not grant a permission you do not have", errorMessages[internal.OrgDelete])
+	})
+
+	t.Run("should not remove permissions from the owners role", func(t *testing.T) {
+		testUser := createTestUser(true)
+		ownerRole := generateRole("all")
+		testOrg := createTestOrg(true, ownerRole, testUser.UserProfile.ID)
+
+		adminUser := createTestUser(true)
+		adminRole, err := testutils.CreateTestRole(ctx, appItems.App.Store, false, testOrg.ID, []string{internal.RoleUpdate})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = testutils.CreateTestOrganizationMember(ctx, appItems.App.Store, testOrg.ID, adminRole.ID, adminUser.UserProfile.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(adminUser.ID, true)}
+		payload := testutils.TestRequestData{
+			"name":        ownerRole.Name,
+			"description": ownerRole.Name + " description",
+			"permissions": []string{internal.RoleUpdate},
+		}
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, ownerRole.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invalid request body", response.GetMessage())
+
+		errorMessages, ok := response.GetErrorMessages()
+		if !ok {
+			t.Fatal("failed to convert response errors to map")
+		}
+		assert.Len(t, errorMessages, len(internal.Permissions)-1)
+		assert.Equal(t, "You can not remove a permission from the owner's role", errorMessages[internal.OrgDelete])
+	})
 }
@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"slices"
 	"strconv"
 
 	"github.com/KengoWada/meetup-clone/internal"
 	"github.com/KengoWada/meetup-clone/internal/logger"
+	"github.com/KengoWada/meetup-clone/internal/middleware"
 	"github.com/KengoWada/meetup-clone/internal/models"
 	"github.com/KengoWada/meetup-clone/internal/services/response"
 	"github.com/KengoWada/meetup-clone/internal/store"
@@ -25,7 +27,7 @@ type updateRolePayload struct {
 // UpdateOrganizationRole godoc
 //
 //	@Summary		Update an organization role
-//	@Description	Update an organization role
+//	@Description	Update an organization role. Permissions can not be removed from the role of the organization's owner
 //	@Tags			roles
 //	@Accept			json
 //	@Produce		json
@@ -58,9 +60,36 @@ func (h *Handler) updateRole(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
 	orgID, _ := strconv.ParseInt(chi.URLParam(r, "orgID"), 10, 64)
 	role, _ := ctx.Value(internal.RoleCtx).(*models.Role)
 
+	errorMessages, err := middleware.ValidateGrantablePermissions(ctx, r, h.store, h.cacheStore, payload.Permissions, user.UserProfile.ID, orgID)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	if errorMessages != nil {
+		err := errors.New("member tried to grant permissions they do not have")
+		errorResponse := response.NewValidationErrorResponse(errorMessages)
+		response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		return
+	}
+
+	errorMessages, err = validateOwnerRolePermissions(ctx, h.store, role, payload.Permissions)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	if errorMessages != nil {
+		err := errors.New("member tried to remove permissions from the owner's role")
+		errorResponse := response.NewValidationErrorResponse(errorMessages)
+		response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		return
+	}
+
 	nameExists, err := roleNameExists(ctx, h.store, string(payload.Name), orgID, role.ID)
 	if err != nil {
 		response.ErrorResponseInternalServerErr(w, r, err)
@@ -104,10 +133,47 @@ func (h *Handler) updateRole(w http.ResponseWriter, r *http.Request) {
 	response.SuccessResponseOK(w, "", simpleRole)
 }
 
+// DeleteOrganizationRole godoc
+//
+//	@Summary		Delete an organization role
+//	@Description	Delete an organization role. Roles that members still hold can only be deleted along with a replacement role, which the members are moved to
+//	@Tags			roles
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID				path		int										true	"orgID the role belongs to"
+//	@Param			roleID				path		int										true	"roleID to delete"
+//	@Param			replacement_role_id	query		int										false	"roleID to move the role's members to"
+//	@Success		200					{object}	response.DocsSuccessResponseDoneMessage	"role successfully deleted"
+//	@Failure		400					{object}	response.DocsErrorResponse
+//	@Failure		401					{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403					{object}	response.DocsErrorResponseForbidden
+//	@Failure		500					{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/roles/{roleID} [delete]
 func (h *Handler) deleteRole(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
 	role, _ := ctx.Value(internal.RoleCtx).(*models.Role)
 
+	if value := r.URL.Query().Get("replacement_role_id"); value != "" {
+		replacementID, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			errorResponse := response.ErrorResponse{Message: "Invalid replacement role ID"}
+			response.ErrorResponseBadRequest(w, r, err, errorResponse)
+			return
+		}
+
+		if replacementID == role.ID {
+			err := errors.New("role replaced with itself")
+			errorResponse := response.ErrorResponse{Message: "Replacement role must be a different role"}
+			response.ErrorResponseBadRequest(w, r, err, errorResponse)
+			return
+		}
+
+		h.replaceRole(w, r, user, role, replacementID)
+		return
+	}
+
 	fields := []string{"role_id", "org_id"}
 	values := []any{role.ID, role.OrganizationID}
 	_, err := h.store.OrganizationMembers.Get(ctx, false, fields, values)
@@ -143,6 +209,82 @@ func (h *Handler) deleteRole(w http.ResponseWriter, r *http.Request) {
 	response.SuccessResponseOK(w, "Done", nil)
 }
 
+// replaceRole deletes the role and moves its members to the replacement
+// role. Members can only be moved to a role whose permissions the user
+// making the request holds. The owner's role can not be replaced, as the
+// owner would lose the permissions of their role.
+func (h *Handler) replaceRole(w http.ResponseWriter, r *http.Request, user *models.User, role *models.Role, replacementID int64) {
+	ctx := r.Context()
+
+	fields := []string{"role_id", "org_id", "is_owner"}
+	values := []any{role.ID, role.OrganizationID, true}
+	_, err := h.store.OrganizationMembers.Get(ctx, false, fields, values)
+	if err != nil && err != store.ErrNotFound {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	if err == nil {
+		err := errors.New("member tried to delete the owner's role")
+		errorResponse := response.ErrorResponse{Message: "You can not delete the owner's role"}
+		response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		return
+	}
+
+	fields = []string{"id", "org_id"}
+	values = []any{replacementID, role.OrganizationID}
+	replacement, err := h.store.Roles.Get(ctx, false, fields, values)
+	if err != nil {
+		switch err {
+		case store.ErrNotFound:
+			errorResponse := response.ErrorResponse{Message: "Invalid replacement role ID"}
+			response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	errorMessages, err := middleware.ValidateGrantablePermissions(ctx, r, h.store, h.cacheStore, replacement.Permissions, user.UserProfile.ID, role.OrganizationID)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	if errorMessages != nil {
+		err := errors.New("member tried to grant permissions they do not have")
+		errorResponse := response.NewValidationErrorResponse(errorMessages)
+		response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		return
+	}
+
+	userIDs, err := h.store.Roles.SoftDeleteAndReassign(ctx, role, replacement.ID)
+	if err != nil {
+		switch err {
+		case store.ErrNotFound:
+			res := response.ErrorResponse{Message: "Try again later"}
+			response.ErrorResponseBadRequest(w, r, err, res)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	if cfg.CacheConfig.Enabled {
+		if err := h.cacheStore.Roles.Delete(role.ID); err != nil {
+			logger.ErrLoggerCache(r, err)
+		}
+
+		for _, userID := range userIDs {
+			if err := h.cacheStore.OrganizationMembers.Delete(userID, role.OrganizationID); err != nil {
+				logger.ErrLoggerCache(r, err)
+			}
+		}
+	}
+
+	response.SuccessResponseOK(w, "Done", nil)
+}
+
 func roleNameExists(ctx context.Context, appStore store.Store, name string, orgID, roleID int64) (bool, error) {
 	fields := []string{"name", "org_id"}
 	values := []any{name, orgID}
@@ -162,3 +304,33 @@ func roleNameExists(ctx context.Context, appStore store.Store, name string, orgI
 
 	return true, nil
 }
+
+// validateOwnerRolePermissions checks that none of the role's permissions
+// are removed when the role is held by the organization's owner, so that
+// members can not lock the owner out of their organization.
+func validateOwnerRolePermissions(ctx context.Context, appStore store.Store, role *models.Role, permissions []string) (response.ErrorsResponse, error) {
+	fields := []string{"role_id", "org_id", "is_owner"}
+	values := []any{role.ID, role.OrganizationID, true}
+	_, err := appStore.OrganizationMembers.Get(ctx, false, fields, values)
+	if err != nil {
+		switch err {
+		case store.ErrNotFound:
+			return nil, nil
+		default:
+			return nil, err
+		}
+	}
+
+	errorMessages := response.ErrorsResponse{}
+	for _, permission := range role.Permissions {
+		if !slices.Contains(permissions, permission) {
+			errorMessages[permission] = "You can not remove a permission from the owner's role"
+		}
+	}
+
+	if len(errorMessages) == 0 {
+		return nil, nil
+	}
+
+	return errorMessages, nil
+}
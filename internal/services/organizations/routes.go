@@ -6,8 +6,10 @@ import (
 	"github.com/KengoWada/meetup-clone/internal"
 	"github.com/KengoWada/meetup-clone/internal/config"
 	"github.com/KengoWada/meetup-clone/internal/middleware"
+	"github.com/KengoWada/meetup-clone/internal/services/organizations/events"
 	"github.com/KengoWada/meetup-clone/internal/services/organizations/members"
 	"github.com/KengoWada/meetup-clone/internal/services/organizations/roles"
+	"github.com/KengoWada/meetup-clone/internal/services/organizations/venues"
 	"github.com/KengoWada/meetup-clone/internal/store"
 	"github.com/KengoWada/meetup-clone/internal/store/cache"
 	"github.com/go-chi/chi/v5"
@@ -40,6 +42,7 @@ func (h *Handler) RegisterRoutes() http.Handler {
 		})
 
 		orgMux.Get("/", h.getOrganization)
+		orgMux.Get("/me", h.getMembership)
 		orgMux.Put(
 			"/",
 			middleware.HasOrgPermission(
@@ -54,7 +57,7 @@ func (h *Handler) RegisterRoutes() http.Handler {
 				[]string{internal.OrgDelete},
 				h.store,
 				h.cacheStore,
-				h.deleteOrganization,
+				middleware.HasTwoFactorCode(h.store, h.deleteOrganization),
 			),
 		)
 
@@ -65,6 +68,14 @@ func (h *Handler) RegisterRoutes() http.Handler {
 		membersHandler := members.NewHandler(h.store, h.cacheStore)
 		membersMux := membersHandler.RegisterRoutes()
 		orgMux.Mount("/members", membersMux)
+
+		venuesHandler := venues.NewHandler(h.store, h.cacheStore)
+		venuesMux := venuesHandler.RegisterRoutes()
+		orgMux.Mount("/venues", venuesMux)
+
+		eventsHandler := events.NewHandler(h.store, h.cacheStore)
+		eventsMux := eventsHandler.RegisterRoutes()
+		orgMux.Mount("/events", eventsMux)
 	})
 
 	return mux
@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/KengoWada/meetup-clone/internal"
 	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/auth"
+	"github.com/KengoWada/meetup-clone/internal/middleware"
 	"github.com/KengoWada/meetup-clone/internal/models"
 	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
 	"github.com/go-faker/faker/v4"
@@ -64,7 +67,7 @@ func TestDeleteOrg(t *testing.T) {
 	}
 
 	generateToken := func(ID int64, isValid bool) string {
-		token, err := testutils.GenerateTesAuthToken(appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -87,6 +90,47 @@ func TestDeleteOrg(t *testing.T) {
 		assert.Equal(t, "Done", response.GetMessage())
 	})
 
+	t.Run("should delete organization with two-factor code", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, generateRole("all"), testUser.UserProfile.ID)
+
+		secret, _, err := testutils.EnableTestTwoFactor(ctx, appItems.App.Store, testUser.ID, []byte(appItems.App.Config.SecretKey))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		endpoint := fmt.Sprintf("%s/%d", testEndpoint, org.ID)
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+
+		response, err := testutils.RunTestRequest(mux, testMethod, endpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Two-factor authentication code required", response.GetMessage())
+
+		headers[middleware.TwoFactorCodeHeader] = "abcde-fghij"
+		response, err = testutils.RunTestRequest(mux, testMethod, endpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invalid two-factor authentication code", response.GetMessage())
+
+		code, err := auth.GenerateTOTP(secret, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		headers[middleware.TwoFactorCodeHeader] = code
+		response, err = testutils.RunTestRequest(mux, testMethod, endpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "Done", response.GetMessage())
+	})
+
 	t.Run("should not delete organization not authenticated", func(t *testing.T) {
 		testUser := createTestUser(true)
 		org := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
@@ -53,7 +53,7 @@ func TestGetOrg(t *testing.T) {
 	}
 
 	generateToken := func(ID int64, isValid bool) string {
-		token, err := testutils.GenerateTesAuthToken(appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -84,6 +84,44 @@ func TestGetOrg(t *testing.T) {
 		assert.Equal(t, org.ProfilePic, data["profilePic"])
 	})
 
+	t.Run("should get an organization with only published events", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true, role, testUser.UserProfile.ID)
+		if _, err := testutils.CreateTestEvent(ctx, appItems.App.Store, false, org.ID); err != nil {
+			t.Fatal(err)
+		}
+		publishedEvent, err := testutils.CreateTestPublishedEvent(ctx, appItems.App.Store, false, org.ID, testUser.UserProfile.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		endpoint := fmt.Sprintf("%s/%d", testEndpoint, org.ID)
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+
+		response, err := testutils.RunTestRequest(mux, testMethod, endpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		events, ok := data["events"].([]any)
+		if !ok {
+			t.Fatal("failed to convert events to slice")
+		}
+		assert.Len(t, events, 1)
+
+		event, ok := events[0].(map[string]any)
+		if !ok {
+			t.Fatal("failed to convert event to map")
+		}
+		assert.EqualValues(t, publishedEvent.ID, event["id"])
+	})
+
 	t.Run("should not get organization not authenticated", func(t *testing.T) {
 		testUser := createTestUser(true)
 		org := createTestOrg(true, role, testUser.UserProfile.ID)
@@ -92,16 +92,17 @@ func (h *Handler) updateOrganization(w http.ResponseWriter, r *http.Request) {
 // DeleteOrganization godoc
 //
 //	@Summary		Delete an organization
-//	@Description	Delete an organization
+//	@Description	Delete an organization. Users with two-factor authentication enabled have to send a code in the X-2FA-Code header
 //	@Tags			organizations
 //	@Accept			json
 //	@Produce		json
-//	@Param			orgID	path		int										true	"orgID to delete"
-//	@Success		200		{object}	response.DocsSuccessResponseDoneMessage	"organization successfully deleted"
-//	@Failure		400		{object}	response.DocsErrorResponse
-//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
-//	@Failure		403		{object}	response.DocsErrorResponseForbidden
-//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Param			orgID		path		int										true	"orgID to delete"
+//	@Param			X-2FA-Code	header		string									false	"two-factor authentication code"
+//	@Success		200			{object}	response.DocsSuccessResponseDoneMessage	"organization successfully deleted"
+//	@Failure		400			{object}	response.DocsErrorResponse
+//	@Failure		401			{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403			{object}	response.DocsErrorResponseForbidden
+//	@Failure		500			{object}	response.DocsErrorResponseInternalServerErr
 //	@Security		ApiKeyAuth
 //	@Router			/organizations/{orgID} [delete]
 func (h *Handler) deleteOrganization(w http.ResponseWriter, r *http.Request) {
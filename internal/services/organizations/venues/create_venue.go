@@ -0,0 +1,75 @@
+package venues
+
+import (
+	"net/http"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/utils"
+	"github.com/KengoWada/meetup-clone/internal/validate"
+)
+
+type venuePayload struct {
+	Name               utils.TrimString `json:"name" validate:"required,max=255"`
+	Address            utils.TrimString `json:"address" validate:"required"`
+	Latitude           *float64         `json:"latitude" validate:"required,min=-90,max=90" example:"0.3476"`
+	Longitude          *float64         `json:"longitude" validate:"required,min=-180,max=180" example:"32.5825"`
+	Capacity           int              `json:"capacity" validate:"required,min=1"`
+	AccessibilityNotes utils.TrimString `json:"accessibilityNotes" validate:"max=1000"`
+}
+
+// CreateOrganizationVenue godoc
+//
+//	@Summary		Create an organization venue
+//	@Description	Create a venue that the organization's events can be held at
+//	@Tags			venues
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID	path		int					true	"orgID to associate venue to"
+//	@Param			payload	body		venuePayload		true	"create organization venue payload"
+//	@Success		201		{object}	models.SimpleVenue	"organization venue successfully created"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403		{object}	response.DocsErrorResponseForbidden
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/venues [post]
+func (h *Handler) createVenue(w http.ResponseWriter, r *http.Request) {
+	var payload venuePayload
+	if err := utils.ReadJSON(w, r, &payload); err != nil {
+		response.ErrorResponseInvalidJSON(w, r, err)
+		return
+	}
+
+	if errorMessages, err := validate.ValidatePayload(payload, venuePayloadErrors); err != nil {
+		switch err {
+		case validate.ErrFailedValidation:
+			errorResponse := response.NewValidationErrorResponse(errorMessages)
+			response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	ctx := r.Context()
+	organization, _ := ctx.Value(internal.OrgCtx).(*models.Organization)
+
+	venue := &models.Venue{
+		OrganizationID:     organization.ID,
+		Name:               string(payload.Name),
+		Address:            string(payload.Address),
+		Latitude:           *payload.Latitude,
+		Longitude:          *payload.Longitude,
+		Capacity:           payload.Capacity,
+		AccessibilityNotes: string(payload.AccessibilityNotes),
+	}
+
+	if err := h.store.Venues.Create(ctx, venue); err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	response.SuccessResponseCreated(w, "Done", newSimpleVenue(venue))
+}
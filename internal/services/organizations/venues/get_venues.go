@@ -0,0 +1,60 @@
+package venues
+
+import (
+	"net/http"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+)
+
+// GetOrganizationVenue godoc
+//
+//	@Summary		Get an organization venue
+//	@Description	Get an organization venue
+//	@Tags			venues
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID	path		int					true	"orgID the venue belongs to"
+//	@Param			venueID	path		int					true	"venueID to fetch"
+//	@Success		200		{object}	models.SimpleVenue	"venue successfully fetched"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403		{object}	response.DocsErrorResponseForbidden
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/venues/{venueID} [get]
+func (h *Handler) getVenue(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	venue, _ := ctx.Value(internal.VenueCtx).(*models.Venue)
+
+	response.SuccessResponseOK(w, "", newSimpleVenue(venue))
+}
+
+// GetOrganizationVenues godoc
+//
+//	@Summary		Get an organization's venues
+//	@Description	Get an organization's venues
+//	@Tags			venues
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID	path		int						true	"id of the org whose venues to fetch"
+//	@Success		200		{object}	[]models.SimpleVenue	"org venues successfully fetched"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/venues [get]
+func (h *Handler) getOrganizationVenues(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	organization, _ := ctx.Value(internal.OrgCtx).(*models.Organization)
+
+	venues, err := h.store.Venues.GetByOrgID(ctx, organization.ID)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	data := map[string]any{"venues": venues}
+	response.SuccessResponseOK(w, "", data)
+}
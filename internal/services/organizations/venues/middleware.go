@@ -0,0 +1,47 @@
+package venues
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/go-chi/chi/v5"
+)
+
+func getVenue(appStore store.Store) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			venueID, err := strconv.ParseInt(chi.URLParam(r, "venueID"), 10, 64)
+			if err != nil {
+				errorMessage := response.ErrorResponse{Message: "Invalid venue ID"}
+				response.ErrorResponseBadRequest(w, r, err, errorMessage)
+				return
+			}
+
+			ctx := r.Context()
+			organization, _ := ctx.Value(internal.OrgCtx).(*models.Organization)
+
+			fields := []string{"id", "org_id"}
+			values := []any{venueID, organization.ID}
+			venue, err := appStore.Venues.Get(ctx, false, fields, values)
+			if err != nil {
+				switch err {
+				case store.ErrNotFound:
+					response.ErrorResponseForbidden(w, r, err)
+				default:
+					response.ErrorResponseInternalServerErr(w, r, err)
+				}
+				return
+			}
+
+			ctx = context.WithValue(ctx, internal.VenueCtx, venue)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
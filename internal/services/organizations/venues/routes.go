@@ -0,0 +1,61 @@
+package venues
+
+import (
+	"net/http"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/middleware"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/store/cache"
+	"github.com/go-chi/chi/v5"
+)
+
+type Handler struct {
+	store      store.Store
+	cacheStore cache.Store
+}
+
+func NewHandler(store store.Store, cacheStore cache.Store) *Handler {
+	return &Handler{store, cacheStore}
+}
+
+func (h *Handler) RegisterRoutes() http.Handler {
+	mux := chi.NewRouter()
+
+	mux.Get("/", h.getOrganizationVenues)
+	mux.Post(
+		"/",
+		middleware.HasOrgPermission(
+			[]string{internal.VenueCreate},
+			h.store,
+			h.cacheStore,
+			h.createVenue,
+		),
+	)
+
+	mux.Route("/{venueID}", func(venueMux chi.Router) {
+		venueMux.Use(getVenue(h.store))
+
+		venueMux.Get("/", h.getVenue)
+		venueMux.Put(
+			"/",
+			middleware.HasOrgPermission(
+				[]string{internal.VenueUpdate},
+				h.store,
+				h.cacheStore,
+				h.updateVenue,
+			),
+		)
+		venueMux.Delete(
+			"/",
+			middleware.HasOrgPermission(
+				[]string{internal.VenueDelete},
+				h.store,
+				h.cacheStore,
+				h.deleteVenue,
+			),
+		)
+	})
+
+	return mux
+}
@@ -0,0 +1,181 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateVenue(t *testing.T) {
+	testEndpoint := func(orgID int64) string {
+		return fmt.Sprintf("/v1/organizations/%d/venues", orgID)
+	}
+	testMethod := http.MethodPost
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func(isActive bool, role *models.Role, userID int64) *models.Organization {
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, isActive, role, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org
+	}
+
+	generateRole := func(permission string) *models.Role {
+		role := &models.Role{Name: faker.Username(options.WithGenerateUniqueValues(true))}
+
+		switch permission {
+		case "valid":
+			role.Permissions = []string{internal.VenueCreate}
+		case "invalid":
+			role.Permissions = []string{internal.VenueUpdate, internal.VenueDelete}
+		default:
+			role.Permissions = internal.Permissions
+		}
+
+		return role
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	generatePayload := func() testutils.TestRequestData {
+		latitude, longitude := testutils.GenerateCoordinates()
+
+		return testutils.TestRequestData{
+			"name":               faker.Username(options.WithGenerateUniqueValues(true)),
+			"address":            "Plot 1, Kampala Road, Kampala",
+			"latitude":           latitude,
+			"longitude":          longitude,
+			"capacity":           120,
+			"accessibilityNotes": "Wheelchair accessible entrance on the left",
+		}
+	}
+
+	t.Run("should create venue", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		payload := generatePayload()
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusCreated, response.StatusCode())
+		assert.Equal(t, "Done", response.GetMessage())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		assert.Equal(t, payload["name"], data["name"])
+		assert.Equal(t, payload["address"], data["address"])
+		assert.InDelta(t, payload["latitude"], data["latitude"], 0.000001)
+		assert.InDelta(t, payload["longitude"], data["longitude"], 0.000001)
+		assert.EqualValues(t, payload["capacity"], data["capacity"])
+		assert.Equal(t, payload["accessibilityNotes"], data["accessibilityNotes"])
+	})
+
+	t.Run("should create venue on the equator", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		payload := generatePayload()
+		payload["latitude"] = 0
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusCreated, response.StatusCode())
+	})
+
+	t.Run("should not create venue with invalid permissions", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("invalid"), testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID), headers, generatePayload())
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+
+	t.Run("should not create venue with invalid data", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		testCases := []struct {
+			field        string
+			value        any
+			errorMessage string
+		}{
+			{"name", "", "Field is required"},
+			{"address", "", "Field is required"},
+			{"latitude", nil, "Field is required"},
+			{"latitude", 90.5, "Latitude should be between -90 and 90"},
+			{"longitude", -181, "Longitude should be between -180 and 180"},
+			{"capacity", -1, "Capacity should be at least 1"},
+		}
+
+		for _, testCase := range testCases {
+			payload := generatePayload()
+			payload[testCase.field] = testCase.value
+
+			response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID), headers, payload)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+			assert.Equal(t, "Invalid request body", response.GetMessage())
+
+			errorMessages, ok := response.GetErrorMessages()
+			if !ok {
+				t.Fatal("failed to convert response errors to map")
+			}
+			assert.Equal(t, testCase.errorMessage, errorMessages[testCase.field])
+		}
+	})
+}
@@ -0,0 +1,161 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateVenue(t *testing.T) {
+	testEndpoint := func(orgID, venueID int64) string {
+		return fmt.Sprintf("/v1/organizations/%d/venues/%d", orgID, venueID)
+	}
+	testMethod := http.MethodPut
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func(isActive bool, role *models.Role, userID int64) *models.Organization {
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, isActive, role, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org
+	}
+
+	generateRole := func(permission string) *models.Role {
+		role := &models.Role{Name: faker.Username(options.WithGenerateUniqueValues(true))}
+
+		switch permission {
+		case "valid":
+			role.Permissions = []string{internal.VenueUpdate}
+		case "invalid":
+			role.Permissions = []string{internal.VenueCreate, internal.VenueDelete}
+		default:
+			role.Permissions = internal.Permissions
+		}
+
+		return role
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	createTestVenue := func(isDeleted bool, orgID int64) *models.Venue {
+		latitude, longitude := testutils.GenerateCoordinates()
+		venue, err := testutils.CreateTestVenue(ctx, appItems.App.Store, isDeleted, orgID, latitude, longitude)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return venue
+	}
+
+	generatePayload := func() testutils.TestRequestData {
+		latitude, longitude := testutils.GenerateCoordinates()
+
+		return testutils.TestRequestData{
+			"name":               faker.Username(options.WithGenerateUniqueValues(true)),
+			"address":            "Plot 1, Kampala Road, Kampala",
+			"latitude":           latitude,
+			"longitude":          longitude,
+			"capacity":           120,
+			"accessibilityNotes": "Wheelchair accessible entrance on the left",
+		}
+	}
+
+	t.Run("should update venue", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		venue := createTestVenue(false, testOrg.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		payload := generatePayload()
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, venue.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		assert.EqualValues(t, venue.ID, data["id"])
+		assert.Equal(t, payload["name"], data["name"])
+		assert.InDelta(t, payload["latitude"], data["latitude"], 0.000001)
+		assert.InDelta(t, payload["longitude"], data["longitude"], 0.000001)
+		assert.EqualValues(t, payload["capacity"], data["capacity"])
+	})
+
+	t.Run("should not update venue with invalid permissions", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("invalid"), testUser.UserProfile.ID)
+		venue := createTestVenue(false, testOrg.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, venue.ID), headers, generatePayload())
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+
+	t.Run("should not update venue with invalid data", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, generateRole("valid"), testUser.UserProfile.ID)
+		venue := createTestVenue(false, testOrg.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		payload := generatePayload()
+		payload["longitude"] = 200
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testOrg.ID, venue.ID), headers, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+
+		errorMessages, ok := response.GetErrorMessages()
+		if !ok {
+			t.Fatal("failed to convert response errors to map")
+		}
+		assert.Equal(t, "Longitude should be between -180 and 180", errorMessages["longitude"])
+	})
+}
@@ -0,0 +1,105 @@
+package venues
+
+import (
+	"net/http"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/utils"
+	"github.com/KengoWada/meetup-clone/internal/validate"
+)
+
+// UpdateOrganizationVenue godoc
+//
+//	@Summary		Update an organization venue
+//	@Description	Update an organization venue
+//	@Tags			venues
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID	path		int					true	"orgID the venue belongs to"
+//	@Param			venueID	path		int					true	"venueID to update"
+//	@Param			payload	body		venuePayload		true	"update organization venue payload"
+//	@Success		200		{object}	models.SimpleVenue	"venue successfully updated"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403		{object}	response.DocsErrorResponseForbidden
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/venues/{venueID} [put]
+func (h *Handler) updateVenue(w http.ResponseWriter, r *http.Request) {
+	var payload venuePayload
+	if err := utils.ReadJSON(w, r, &payload); err != nil {
+		response.ErrorResponseInvalidJSON(w, r, err)
+		return
+	}
+
+	if errorMessages, err := validate.ValidatePayload(payload, venuePayloadErrors); err != nil {
+		switch err {
+		case validate.ErrFailedValidation:
+			errorResponse := response.NewValidationErrorResponse(errorMessages)
+			response.ErrorResponseBadRequest(w, r, err, errorResponse)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	ctx := r.Context()
+	venue, _ := ctx.Value(internal.VenueCtx).(*models.Venue)
+
+	venue.Name = string(payload.Name)
+	venue.Address = string(payload.Address)
+	venue.Latitude = *payload.Latitude
+	venue.Longitude = *payload.Longitude
+	venue.Capacity = payload.Capacity
+	venue.AccessibilityNotes = string(payload.AccessibilityNotes)
+
+	if err := h.store.Venues.Update(ctx, venue); err != nil {
+		switch err {
+		case store.ErrNotFound:
+			res := response.ErrorResponse{Message: "Try again later"}
+			response.ErrorResponseBadRequest(w, r, err, res)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	response.SuccessResponseOK(w, "", newSimpleVenue(venue))
+}
+
+// DeleteOrganizationVenue godoc
+//
+//	@Summary		Delete an organization venue
+//	@Description	Delete an organization venue. Events that were held at the venue keep it
+//	@Tags			venues
+//	@Accept			json
+//	@Produce		json
+//	@Param			orgID	path		int										true	"orgID the venue belongs to"
+//	@Param			venueID	path		int										true	"venueID to delete"
+//	@Success		200		{object}	response.DocsSuccessResponseDoneMessage	"venue successfully deleted"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403		{object}	response.DocsErrorResponseForbidden
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/organizations/{orgID}/venues/{venueID} [delete]
+func (h *Handler) deleteVenue(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	venue, _ := ctx.Value(internal.VenueCtx).(*models.Venue)
+
+	if err := h.store.Venues.SoftDelete(ctx, venue); err != nil {
+		switch err {
+		case store.ErrNotFound:
+			res := response.ErrorResponse{Message: "Try again later"}
+			response.ErrorResponseBadRequest(w, r, err, res)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	response.SuccessResponseOK(w, "Done", nil)
+}
@@ -0,0 +1,43 @@
+package venues
+
+import (
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/validate"
+)
+
+var (
+	venuePayloadErrors = validate.FieldErrorMessages{
+		"name": validate.TagErrorMessages{
+			"max": "Name should be at most 255 characters",
+		},
+		"address": validate.TagErrorMessages{},
+		"latitude": validate.TagErrorMessages{
+			"min": "Latitude should be between -90 and 90",
+			"max": "Latitude should be between -90 and 90",
+		},
+		"longitude": validate.TagErrorMessages{
+			"min": "Longitude should be between -180 and 180",
+			"max": "Longitude should be between -180 and 180",
+		},
+		"capacity": validate.TagErrorMessages{
+			"min": "Capacity should be at least 1",
+		},
+		"accessibilityNotes": validate.TagErrorMessages{
+			"max": "Accessibility notes should be at most 1000 characters",
+		},
+	}
+)
+
+// newSimpleVenue converts a venue into the representation that is
+// returned to clients.
+func newSimpleVenue(venue *models.Venue) models.SimpleVenue {
+	return models.SimpleVenue{
+		ID:                 venue.ID,
+		Name:               venue.Name,
+		Address:            venue.Address,
+		Latitude:           venue.Latitude,
+		Longitude:          venue.Longitude,
+		Capacity:           venue.Capacity,
+		AccessibilityNotes: venue.AccessibilityNotes,
+	}
+}
@@ -0,0 +1,184 @@
+package profiles
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+)
+
+// GetInvites godoc
+//
+//	@Summary		Get a users pending organization invites
+//	@Description	Get the organization invites a user has not responded to and that have not expired, newest first
+//	@Tags			profiles
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	[]models.PendingInvite	"invites successfully fetched"
+//	@Failure		401	{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		500	{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/profiles/invites [get]
+func (h *Handler) getInvites(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+
+	invites, err := h.store.OrganizationInvites.GetPendingByUserID(ctx, user.UserProfile.ID)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	response.SuccessResponseOK(w, "", map[string]any{"invites": invites})
+}
+
+// AcceptInvite godoc
+//
+//	@Summary		Accept an organization invite
+//	@Description	Accept an organization invite and join the organization with the role the invite was sent with
+//	@Tags			profiles
+//	@Accept			json
+//	@Produce		json
+//	@Param			inviteID	path		int							true	"inviteID to accept"
+//	@Success		200			{object}	models.OrganizationMember	"invite successfully accepted"
+//	@Failure		400			{object}	response.DocsErrorResponse
+//	@Failure		401			{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403			{object}	response.DocsErrorResponseForbidden
+//	@Failure		500			{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/profiles/invites/{inviteID}/accept [patch]
+func (h *Handler) acceptInvite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	invite, _ := ctx.Value(internal.InviteCtx).(*models.OrganizationInvite)
+
+	if !invite.IsPending() {
+		err := errors.New("user tried to accept an invite they already responded to")
+		errorMessage := response.ErrorResponse{Message: "You have already responded to this invite"}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	if invite.IsExpired() {
+		err := errors.New("user tried to accept an expired invite")
+		errorMessage := response.ErrorResponse{Message: "Invite has expired"}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	valid, err := isInviteValid(ctx, h.store, invite)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	if !valid {
+		err := errors.New("user tried to accept an invite to an inactive organization or deleted role")
+		errorMessage := response.ErrorResponse{Message: "Invite is no longer valid"}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	fields := []string{"user_id", "org_id"}
+	values := []any{*invite.UserProfileID, invite.OrganizationID}
+	_, err = h.store.OrganizationMembers.Get(ctx, false, fields, values)
+	if err != nil && err != store.ErrNotFound {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	if err == nil {
+		err := errors.New("member tried to accept an invite to their organization")
+		errorMessage := response.ErrorResponse{Message: "You are already a member of this organization"}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	member := &models.OrganizationMember{}
+	if err := h.store.OrganizationInvites.Accept(ctx, invite, member); err != nil {
+		switch err {
+		case store.ErrNotFound:
+			errorMessage := response.ErrorResponse{Message: "Try again later"}
+			response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		case store.ErrDuplicateMember:
+			errorMessage := response.ErrorResponse{Message: "You are already a member of this organization"}
+			response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	response.SuccessResponseOK(w, "Invite accepted", member)
+}
+
+// DeclineInvite godoc
+//
+//	@Summary		Decline an organization invite
+//	@Description	Decline an organization invite
+//	@Tags			profiles
+//	@Accept			json
+//	@Produce		json
+//	@Param			inviteID	path		int									true	"inviteID to decline"
+//	@Success		200			{object}	response.DocsResponseMessageOnly	"invite successfully declined"
+//	@Failure		400			{object}	response.DocsErrorResponse
+//	@Failure		401			{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403			{object}	response.DocsErrorResponseForbidden
+//	@Failure		500			{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/profiles/invites/{inviteID}/decline [patch]
+func (h *Handler) declineInvite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	invite, _ := ctx.Value(internal.InviteCtx).(*models.OrganizationInvite)
+
+	if !invite.IsPending() {
+		err := errors.New("user tried to decline an invite they already responded to")
+		errorMessage := response.ErrorResponse{Message: "You have already responded to this invite"}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	if err := h.store.OrganizationInvites.Decline(ctx, invite); err != nil {
+		switch err {
+		case store.ErrNotFound:
+			errorMessage := response.ErrorResponse{Message: "Try again later"}
+			response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	response.SuccessResponseOK(w, "Invite declined", nil)
+}
+
+// isInviteValid checks that the organization the invite is for is still
+// active and that the role the invite was sent with has not been deleted.
+func isInviteValid(ctx context.Context, appStore store.Store, invite *models.OrganizationInvite) (bool, error) {
+	fields := []string{"id", "is_active"}
+	values := []any{invite.OrganizationID, true}
+	if _, err := appStore.Organizations.Get(ctx, false, fields, values); err != nil {
+		switch err {
+		case store.ErrNotFound:
+			return false, nil
+		default:
+			return false, err
+		}
+	}
+
+	fields = []string{"id", "org_id"}
+	values = []any{invite.RoleID, invite.OrganizationID}
+	if _, err := appStore.Roles.Get(ctx, false, fields, values); err != nil {
+		switch err {
+		case store.ErrNotFound:
+			return false, nil
+		default:
+			return false, err
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,49 @@
+package profiles
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/go-chi/chi/v5"
+)
+
+// getInvite loads the invite in the URL into the request context. Users can
+// only load invites that were sent to them.
+func getInvite(appStore store.Store) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			inviteID, err := strconv.ParseInt(chi.URLParam(r, "inviteID"), 10, 64)
+			if err != nil {
+				errorMessage := response.ErrorResponse{Message: "Invalid invite ID"}
+				response.ErrorResponseBadRequest(w, r, err, errorMessage)
+				return
+			}
+
+			ctx := r.Context()
+			user, _ := ctx.Value(internal.UserCtx).(*models.User)
+
+			fields := []string{"id", "user_id"}
+			values := []any{inviteID, user.UserProfile.ID}
+			invite, err := appStore.OrganizationInvites.Get(ctx, false, fields, values)
+			if err != nil {
+				switch err {
+				case store.ErrNotFound:
+					response.ErrorResponseForbidden(w, r, err)
+				default:
+					response.ErrorResponseInternalServerErr(w, r, err)
+				}
+				return
+			}
+
+			ctx = context.WithValue(ctx, internal.InviteCtx, invite)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
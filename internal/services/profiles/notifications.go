@@ -0,0 +1,34 @@
+package profiles
+
+import (
+	"net/http"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+)
+
+// GetNotifications godoc
+//
+//	@Summary		Get a users notifications
+//	@Description	Get a users notifications, newest first
+//	@Tags			profiles
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	[]models.Notification	"notifications successfully fetched"
+//	@Failure		401	{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		500	{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/profiles/notifications [get]
+func (h *Handler) getNotifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+
+	notifications, err := h.store.Notifications.GetByUserID(ctx, user.UserProfile.ID)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	response.SuccessResponseOK(w, "", map[string]any{"notifications": notifications})
+}
@@ -4,18 +4,23 @@ import (
 	"net/http"
 
 	"github.com/KengoWada/meetup-clone/internal/auth"
+	"github.com/KengoWada/meetup-clone/internal/config"
 	"github.com/KengoWada/meetup-clone/internal/middleware"
 	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/store/cache"
 	"github.com/go-chi/chi/v5"
 )
 
+var cfg = config.Get()
+
 type Handler struct {
 	store         store.Store
+	cacheStore    cache.Store
 	authenticator auth.Authenticator
 }
 
-func NewHandler(store store.Store, authenticator auth.Authenticator) *Handler {
-	return &Handler{store, authenticator}
+func NewHandler(store store.Store, cacheStore cache.Store, authenticator auth.Authenticator) *Handler {
+	return &Handler{store, cacheStore, authenticator}
 }
 
 func (h *Handler) RegisterRoutes() http.Handler {
@@ -27,6 +32,25 @@ func (h *Handler) RegisterRoutes() http.Handler {
 		r.Get("/", h.getPersonalProfile)
 		r.Put("/", h.updateUserProfile)
 		r.Delete("/", h.deleteUserProfile)
+
+		r.Get("/notifications", h.getNotifications)
+
+		r.Get("/sessions", h.getSessions)
+		r.Delete("/sessions", h.revokeOtherSessions)
+		r.Delete("/sessions/{sessionID}", h.revokeSession)
+
+		r.Post("/2fa", h.enrollTwoFactor)
+		r.Get("/2fa/qr", h.getTwoFactorQRCode)
+		r.Post("/2fa/confirm", h.confirmTwoFactor)
+		r.Post("/2fa/disable", h.disableTwoFactor)
+
+		r.Get("/invites", h.getInvites)
+		r.Route("/invites/{inviteID}", func(inviteMux chi.Router) {
+			inviteMux.Use(getInvite(h.store))
+
+			inviteMux.Patch("/accept", h.acceptInvite)
+			inviteMux.Patch("/decline", h.declineInvite)
+		})
 	})
 
 	return mux
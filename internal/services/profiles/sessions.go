@@ -0,0 +1,167 @@
+package profiles
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/logger"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/go-chi/chi/v5"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type sessionResponse struct {
+	ID         int64  `json:"id"`
+	Device     string `json:"device"`
+	IPAddress  string `json:"ipAddress"`
+	UserAgent  string `json:"userAgent"`
+	LastSeenAt string `json:"lastSeenAt"`
+	CreatedAt  string `json:"createdAt"`
+	Current    bool   `json:"current"`
+}
+
+// GetSessions godoc
+//
+//	@Summary		Get a users sessions
+//	@Description	Get the devices a user is logged in on, most recently used first. The session the request was made from is marked as current
+//	@Tags			profiles
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	[]sessionResponse	"sessions successfully fetched"
+//	@Failure		401	{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		500	{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/profiles/sessions [get]
+func (h *Handler) getSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+
+	sessions, err := h.store.Sessions.GetActiveByUserID(ctx, user.ID)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	currentID := currentSessionID(r)
+	data := make([]sessionResponse, 0, len(sessions))
+	for _, session := range sessions {
+		data = append(data, sessionResponse{
+			ID:         session.ID,
+			Device:     session.Device,
+			IPAddress:  session.IPAddress,
+			UserAgent:  session.UserAgent,
+			LastSeenAt: session.LastSeenAt,
+			CreatedAt:  session.CreatedAt,
+			Current:    session.ID == currentID,
+		})
+	}
+
+	response.SuccessResponseOK(w, "", map[string]any{"sessions": data})
+}
+
+// RevokeSession godoc
+//
+//	@Summary		Revoke a session
+//	@Description	Log a device out. Access tokens issued for the session stop working and its refresh token can no longer be used
+//	@Tags			profiles
+//	@Accept			json
+//	@Produce		json
+//	@Param			sessionID	path		int									true	"sessionID to revoke"
+//	@Success		200			{object}	response.DocsResponseMessageOnly	"session successfully revoked"
+//	@Failure		400			{object}	response.DocsErrorResponse
+//	@Failure		401			{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		403			{object}	response.DocsErrorResponseForbidden
+//	@Failure		500			{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/profiles/sessions/{sessionID} [delete]
+func (h *Handler) revokeSession(w http.ResponseWriter, r *http.Request) {
+	sessionID, err := strconv.ParseInt(chi.URLParam(r, "sessionID"), 10, 64)
+	if err != nil {
+		errorMessage := response.ErrorResponse{Message: "Invalid session ID"}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+
+	session, err := h.store.Sessions.GetByID(ctx, sessionID)
+	if err != nil {
+		switch err {
+		case store.ErrNotFound:
+			response.ErrorResponseForbidden(w, r, err)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	if session.UserID != user.ID {
+		err := errors.New("user tried to revoke another user's session")
+		response.ErrorResponseForbidden(w, r, err)
+		return
+	}
+
+	if err := h.store.Sessions.Revoke(ctx, session); err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	if cfg.CacheConfig.Enabled {
+		if err := h.cacheStore.Sessions.Delete(session.ID); err != nil {
+			logger.ErrLoggerCache(r, err)
+		}
+	}
+
+	response.SuccessResponseOK(w, "Session revoked", nil)
+}
+
+// RevokeOtherSessions godoc
+//
+//	@Summary		Revoke a users other sessions
+//	@Description	Log out every device except the one the request was made from
+//	@Tags			profiles
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	response.DocsResponseMessageOnly	"sessions successfully revoked"
+//	@Failure		401	{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		500	{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/profiles/sessions [delete]
+func (h *Handler) revokeOtherSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+
+	sessionIDs, err := h.store.Sessions.RevokeOthers(ctx, user.ID, currentSessionID(r))
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	if cfg.CacheConfig.Enabled {
+		for _, sessionID := range sessionIDs {
+			if err := h.cacheStore.Sessions.Delete(sessionID); err != nil {
+				logger.ErrLoggerCache(r, err)
+			}
+		}
+	}
+
+	response.SuccessResponseOK(w, "Other sessions revoked", nil)
+}
+
+// currentSessionID returns the ID of the session the request's access token
+// was issued for, or 0 if the token has no session.
+func currentSessionID(r *http.Request) int64 {
+	claims, _ := r.Context().Value(internal.TokenClaimsCtx).(jwt.MapClaims)
+	if _, ok := claims["sid"]; !ok {
+		return 0
+	}
+
+	sessionID, _ := strconv.ParseInt(fmt.Sprintf("%.f", claims["sid"]), 10, 64)
+	return sessionID
+}
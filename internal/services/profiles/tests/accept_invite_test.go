@@ -0,0 +1,219 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcceptInvite(t *testing.T) {
+	testEndpoint := func(inviteID int64) string {
+		return fmt.Sprintf("/v1/profiles/invites/%d/accept", inviteID)
+	}
+	testMethod := http.MethodPatch
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func(isActive bool) *models.Organization {
+		owner := createTestUser(true)
+		role := &models.Role{
+			Name:        faker.Username(options.WithGenerateUniqueValues(true)),
+			Permissions: internal.Permissions,
+		}
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, isActive, role, owner.UserProfile.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org
+	}
+
+	createTestInvite := func(org *models.Organization, isRoleDeleted, isExpired bool, userID int64) *models.OrganizationInvite {
+		role, err := testutils.CreateTestRole(ctx, appItems.App.Store, isRoleDeleted, org.ID, []string{internal.EventCreate})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		invite, err := testutils.CreateTestOrganizationInvite(ctx, appItems.App.Store, isExpired, org.ID, role.ID, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return invite
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	t.Run("should accept invite", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true)
+		invite := createTestInvite(org, false, false, testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(invite.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "Invite accepted", response.GetMessage())
+
+		fields := []string{"user_id", "org_id"}
+		values := []any{testUser.UserProfile.ID, org.ID}
+		member, err := appItems.App.Store.OrganizationMembers.Get(ctx, false, fields, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, invite.RoleID, member.RoleID)
+
+		fields = []string{"id"}
+		values = []any{invite.ID}
+		invite, err = appItems.App.Store.OrganizationInvites.Get(ctx, false, fields, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.NotNil(t, invite.AcceptedAt)
+	})
+
+	t.Run("should not accept invite already responded to", func(t *testing.T) {
+		testUser := createTestUser(true)
+		invite := createTestInvite(createTestOrg(true), false, false, testUser.UserProfile.ID)
+		if err := appItems.App.Store.OrganizationInvites.Decline(ctx, invite); err != nil {
+			t.Fatal(err)
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(invite.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "You have already responded to this invite", response.GetMessage())
+	})
+
+	t.Run("should not accept expired invite", func(t *testing.T) {
+		testUser := createTestUser(true)
+		invite := createTestInvite(createTestOrg(true), false, true, testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(invite.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invite has expired", response.GetMessage())
+	})
+
+	t.Run("should not accept invite to inactive organization", func(t *testing.T) {
+		testUser := createTestUser(true)
+		invite := createTestInvite(createTestOrg(false), false, false, testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(invite.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invite is no longer valid", response.GetMessage())
+	})
+
+	t.Run("should not accept invite with deleted role", func(t *testing.T) {
+		testUser := createTestUser(true)
+		invite := createTestInvite(createTestOrg(true), true, false, testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(invite.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invite is no longer valid", response.GetMessage())
+	})
+
+	t.Run("should not accept invite already a member", func(t *testing.T) {
+		testUser := createTestUser(true)
+		org := createTestOrg(true)
+		invite := createTestInvite(org, false, false, testUser.UserProfile.ID)
+		if _, err := testutils.CreateTestOrganizationMember(ctx, appItems.App.Store, org.ID, invite.RoleID, testUser.UserProfile.ID); err != nil {
+			t.Fatal(err)
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(invite.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "You are already a member of this organization", response.GetMessage())
+	})
+
+	t.Run("should not accept another users invite", func(t *testing.T) {
+		testUser := createTestUser(true)
+		otherUser := createTestUser(true)
+		invite := createTestInvite(createTestOrg(true), false, false, otherUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(invite.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+
+	t.Run("should not accept invite invalid invite id", func(t *testing.T) {
+		testUser := createTestUser(true)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, "/v1/profiles/invites/abc/accept", headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invalid invite ID", response.GetMessage())
+	})
+
+	t.Run("should not accept invite not authenticated", func(t *testing.T) {
+		testUser := createTestUser(true)
+		invite := createTestInvite(createTestOrg(true), false, false, testUser.UserProfile.ID)
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(invite.ID), nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+		assert.Equal(t, "unauthorized", response.GetMessage())
+	})
+}
@@ -0,0 +1,144 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeclineInvite(t *testing.T) {
+	testEndpoint := func(inviteID int64) string {
+		return fmt.Sprintf("/v1/profiles/invites/%d/decline", inviteID)
+	}
+	testMethod := http.MethodPatch
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestInvite := func(userID int64) *models.OrganizationInvite {
+		owner := createTestUser(true)
+		ownerRole := &models.Role{
+			Name:        faker.Username(options.WithGenerateUniqueValues(true)),
+			Permissions: internal.Permissions,
+		}
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, true, ownerRole, owner.UserProfile.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		role, err := testutils.CreateTestRole(ctx, appItems.App.Store, false, org.ID, []string{internal.EventCreate})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		invite, err := testutils.CreateTestOrganizationInvite(ctx, appItems.App.Store, false, org.ID, role.ID, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return invite
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	t.Run("should decline invite", func(t *testing.T) {
+		testUser := createTestUser(true)
+		invite := createTestInvite(testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(invite.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "Invite declined", response.GetMessage())
+
+		fields := []string{"id"}
+		values := []any{invite.ID}
+		invite, err = appItems.App.Store.OrganizationInvites.Get(ctx, false, fields, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.NotNil(t, invite.DeclinedAt)
+
+		fields = []string{"user_id", "org_id"}
+		values = []any{testUser.UserProfile.ID, invite.OrganizationID}
+		_, err = appItems.App.Store.OrganizationMembers.Get(ctx, false, fields, values)
+		assert.Equal(t, store.ErrNotFound, err)
+	})
+
+	t.Run("should not decline invite already responded to", func(t *testing.T) {
+		testUser := createTestUser(true)
+		invite := createTestInvite(testUser.UserProfile.ID)
+		if err := appItems.App.Store.OrganizationInvites.Accept(ctx, invite, &models.OrganizationMember{}); err != nil {
+			t.Fatal(err)
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(invite.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "You have already responded to this invite", response.GetMessage())
+	})
+
+	t.Run("should not decline another users invite", func(t *testing.T) {
+		testUser := createTestUser(true)
+		otherUser := createTestUser(true)
+		invite := createTestInvite(otherUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(invite.ID), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+	})
+
+	t.Run("should not decline invite not authenticated", func(t *testing.T) {
+		testUser := createTestUser(true)
+		invite := createTestInvite(testUser.UserProfile.ID)
+
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(invite.ID), nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+		assert.Equal(t, "unauthorized", response.GetMessage())
+	})
+}
@@ -0,0 +1,155 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetInvites(t *testing.T) {
+	testEndpoint := "/v1/profiles/invites"
+	testMethod := http.MethodGet
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func(isActive bool) *models.Organization {
+		owner := createTestUser(true)
+		role := &models.Role{
+			Name:        faker.Username(options.WithGenerateUniqueValues(true)),
+			Permissions: internal.Permissions,
+		}
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, isActive, role, owner.UserProfile.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org
+	}
+
+	createTestInvite := func(org *models.Organization, isRoleDeleted, isExpired bool, userID int64) *models.OrganizationInvite {
+		role, err := testutils.CreateTestRole(ctx, appItems.App.Store, isRoleDeleted, org.ID, []string{internal.EventCreate})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		invite, err := testutils.CreateTestOrganizationInvite(ctx, appItems.App.Store, isExpired, org.ID, role.ID, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return invite
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	t.Run("should get a users pending invites", func(t *testing.T) {
+		testUser := createTestUser(true)
+		otherUser := createTestUser(true)
+		org := createTestOrg(true)
+		invite := createTestInvite(org, false, false, testUser.UserProfile.ID)
+		createTestInvite(createTestOrg(true), false, false, otherUser.UserProfile.ID)
+
+		declinedInvite := createTestInvite(createTestOrg(true), false, false, testUser.UserProfile.ID)
+		if err := appItems.App.Store.OrganizationInvites.Decline(ctx, declinedInvite); err != nil {
+			t.Fatal(err)
+		}
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		invites, ok := data["invites"].([]any)
+		if !ok {
+			t.Fatal("failed to convert invites to slice")
+		}
+		assert.Len(t, invites, 1)
+
+		inviteData, ok := invites[0].(map[string]any)
+		if !ok {
+			t.Fatal("failed to convert invite to map")
+		}
+		assert.EqualValues(t, invite.ID, inviteData["id"])
+
+		orgData, ok := inviteData["organization"].(map[string]any)
+		if !ok {
+			t.Fatal("failed to convert organization to map")
+		}
+		assert.EqualValues(t, org.ID, orgData["id"])
+		assert.Equal(t, org.Name, orgData["name"])
+
+		roleData, ok := inviteData["role"].(map[string]any)
+		if !ok {
+			t.Fatal("failed to convert role to map")
+		}
+		assert.EqualValues(t, invite.RoleID, roleData["id"])
+	})
+
+	t.Run("should not get expired invites or invites to inactive organizations or deleted roles", func(t *testing.T) {
+		testUser := createTestUser(true)
+		createTestInvite(createTestOrg(false), false, false, testUser.UserProfile.ID)
+		createTestInvite(createTestOrg(true), true, false, testUser.UserProfile.ID)
+		createTestInvite(createTestOrg(true), false, true, testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+		assert.Nil(t, data["invites"])
+	})
+
+	t.Run("should not get invites not authenticated", func(t *testing.T) {
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+		assert.Equal(t, "unauthorized", response.GetMessage())
+	})
+}
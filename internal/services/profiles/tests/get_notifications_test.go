@@ -0,0 +1,120 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetNotifications(t *testing.T) {
+	testEndpoint := "/v1/profiles/notifications"
+	testMethod := http.MethodGet
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createCancelledEvent := func(attendeeIDs ...int64) *models.Event {
+		organizer := createTestUser(true)
+		role := &models.Role{
+			Name:        faker.Username(options.WithGenerateUniqueValues(true)),
+			Permissions: []string{internal.EventCancel},
+		}
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, true, role, organizer.UserProfile.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		event, err := testutils.CreateTestPublishedEvent(ctx, appItems.App.Store, false, org.ID, organizer.UserProfile.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, attendeeID := range attendeeIDs {
+			if _, err := testutils.CreateTestRSVP(ctx, appItems.App.Store, event.ID, attendeeID, models.RSVPGoing); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		event.CancellationReason = "Cancelled for testing"
+		notification := &models.Notification{Type: models.NotificationEventCancelled, Message: event.CancellationReason}
+		if err := appItems.App.Store.Events.Cancel(ctx, event, notification); err != nil {
+			t.Fatal(err)
+		}
+
+		return event
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	t.Run("should get a users notifications", func(t *testing.T) {
+		testUser := createTestUser(true)
+		otherUser := createTestUser(true)
+		createCancelledEvent(testUser.UserProfile.ID, otherUser.UserProfile.ID)
+		latestEvent := createCancelledEvent(testUser.UserProfile.ID)
+
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		notifications, ok := data["notifications"].([]any)
+		if !ok {
+			t.Fatal("failed to convert notifications to slice")
+		}
+		assert.Len(t, notifications, 2)
+
+		notification, ok := notifications[0].(map[string]any)
+		if !ok {
+			t.Fatal("failed to convert notification to map")
+		}
+		assert.EqualValues(t, latestEvent.ID, notification["eventId"])
+		assert.Equal(t, string(models.NotificationEventCancelled), notification["type"])
+	})
+
+	t.Run("should not get notifications not authenticated", func(t *testing.T) {
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+		assert.Equal(t, "unauthorized", response.GetMessage())
+	})
+}
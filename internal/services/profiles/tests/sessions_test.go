@@ -0,0 +1,241 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/auth"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserSessions(t *testing.T) {
+	testEndpoint := "/v1/profiles/sessions"
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func() testutils.TestUserData {
+		testUserData := testutils.NewTestUserData(true)
+		_, _, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return testUserData
+	}
+
+	login := func(t *testing.T, testUserData testutils.TestUserData, device string) testutils.TestRequestHeaders {
+		data := testutils.TestRequestData{"email": testUserData.Email, "password": testUserData.Password, "device": device}
+		response, err := testutils.RunTestRequest(mux, http.MethodPost, "/v1/auth/login", nil, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		responseData, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		token, _ := responseData["token"].(string)
+		return testutils.TestRequestHeaders{"Authorization": "Bearer " + token}
+	}
+
+	getSessions := func(t *testing.T, headers testutils.TestRequestHeaders) []map[string]any {
+		response, err := testutils.RunTestRequest(mux, http.MethodGet, testEndpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		responseData, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		rawSessions, _ := responseData["sessions"].([]any)
+		sessions := make([]map[string]any, 0, len(rawSessions))
+		for _, rawSession := range rawSessions {
+			session, _ := rawSession.(map[string]any)
+			sessions = append(sessions, session)
+		}
+		return sessions
+	}
+
+	assertAuthorized := func(t *testing.T, headers testutils.TestRequestHeaders, isAuthorized bool) {
+		response, err := testutils.RunTestRequest(mux, http.MethodGet, "/v1/profiles", headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if isAuthorized {
+			assert.Equal(t, http.StatusOK, response.StatusCode())
+		} else {
+			assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+		}
+	}
+
+	t.Run("should get a users sessions", func(t *testing.T) {
+		testUserData := createTestUser()
+		login(t, testUserData, "Laptop")
+		headers := login(t, testUserData, "Phone")
+
+		sessions := getSessions(t, headers)
+		assert.Len(t, sessions, 2)
+
+		devices := map[string]bool{}
+		for _, session := range sessions {
+			devices[session["device"].(string)] = session["current"].(bool)
+			assert.Contains(t, session, "ipAddress")
+			assert.NotEmpty(t, session["lastSeenAt"])
+		}
+		assert.Equal(t, map[string]bool{"Laptop": false, "Phone": true}, devices)
+	})
+
+	t.Run("should revoke a session", func(t *testing.T) {
+		testUserData := createTestUser()
+		laptopHeaders := login(t, testUserData, "Laptop")
+		headers := login(t, testUserData, "Phone")
+
+		var laptopSessionID any
+		for _, session := range getSessions(t, headers) {
+			if session["device"] == "Laptop" {
+				laptopSessionID = session["id"]
+			}
+		}
+
+		endpoint := fmt.Sprintf("%s/%.f", testEndpoint, laptopSessionID)
+		response, err := testutils.RunTestRequest(mux, http.MethodDelete, endpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "Session revoked", response.GetMessage())
+
+		assertAuthorized(t, laptopHeaders, false)
+		assertAuthorized(t, headers, true)
+		assert.Len(t, getSessions(t, headers), 1)
+	})
+
+	t.Run("should not touch a revoked session", func(t *testing.T) {
+		testUserData := createTestUser()
+		headers := login(t, testUserData, "Laptop")
+
+		sessionID, _ := getSessions(t, headers)[0]["id"].(float64)
+		session, err := appItems.App.Store.Sessions.GetByID(ctx, int64(sessionID))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = appItems.App.Store.Sessions.Revoke(ctx, session)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = appItems.App.Store.Sessions.Touch(ctx, session)
+		assert.Equal(t, store.ErrNotFound, err)
+		assertAuthorized(t, headers, false)
+	})
+
+	t.Run("should revoke a users other sessions", func(t *testing.T) {
+		testUserData := createTestUser()
+		laptopHeaders := login(t, testUserData, "Laptop")
+		tabletHeaders := login(t, testUserData, "Tablet")
+		headers := login(t, testUserData, "Phone")
+
+		response, err := testutils.RunTestRequest(mux, http.MethodDelete, testEndpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "Other sessions revoked", response.GetMessage())
+
+		assertAuthorized(t, laptopHeaders, false)
+		assertAuthorized(t, tabletHeaders, false)
+		assertAuthorized(t, headers, true)
+
+		sessions := getSessions(t, headers)
+		assert.Len(t, sessions, 1)
+		assert.Equal(t, "Phone", sessions[0]["device"])
+	})
+
+	t.Run("should not revoke another users session", func(t *testing.T) {
+		otherUserData := createTestUser()
+		otherHeaders := login(t, otherUserData, "Laptop")
+		otherSessionID := getSessions(t, otherHeaders)[0]["id"]
+
+		headers := login(t, createTestUser(), "Phone")
+
+		endpoint := fmt.Sprintf("%s/%.f", testEndpoint, otherSessionID)
+		response, err := testutils.RunTestRequest(mux, http.MethodDelete, endpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusForbidden, response.StatusCode())
+		assert.Equal(t, "forbidden", response.GetMessage())
+
+		assertAuthorized(t, otherHeaders, true)
+	})
+
+	t.Run("should not revoke a session with an invalid ID", func(t *testing.T) {
+		headers := login(t, createTestUser(), "Phone")
+
+		endpoint := fmt.Sprintf("%s/abc", testEndpoint)
+		response, err := testutils.RunTestRequest(mux, http.MethodDelete, endpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invalid session ID", response.GetMessage())
+	})
+
+	t.Run("should not authorize a token without a session", func(t *testing.T) {
+		testUserData := createTestUser()
+		fields, values := []string{"email"}, []any{testUserData.Email}
+		user, err := appItems.App.Store.Users.Get(ctx, false, fields, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		jti, err := auth.GenerateTokenID()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		token, err := appItems.App.Authenticator.GenerateToken(jwt.MapClaims{
+			"jti": jti,
+			"sub": user.ID,
+			"exp": time.Now().Add(time.Minute * 10).Unix(),
+			"iat": time.Now().Unix(),
+			"nbf": time.Now().Unix(),
+			"aud": appItems.App.Config.AuthConfig.Audience,
+			"iss": appItems.App.Config.AuthConfig.Issuer,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assertAuthorized(t, testutils.TestRequestHeaders{"Authorization": "Bearer " + token}, false)
+	})
+
+	t.Run("should not get sessions without a token", func(t *testing.T) {
+		response, err := testutils.RunTestRequest(mux, http.MethodGet, testEndpoint, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+		assert.Equal(t, "unauthorized", response.GetMessage())
+	})
+}
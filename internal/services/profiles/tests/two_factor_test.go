@@ -0,0 +1,248 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/auth"
+	"github.com/KengoWada/meetup-clone/internal/middleware"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTwoFactor(t *testing.T) {
+	testEndpoint := "/v1/profiles/2fa"
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func() *models.User {
+		testUserData := testutils.NewTestUserData(true)
+		user, _, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return user
+	}
+
+	generateHeaders := func(ID int64) testutils.TestRequestHeaders {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, true, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return testutils.TestRequestHeaders{"Authorization": "Bearer " + token}
+	}
+
+	enroll := func(t *testing.T, headers testutils.TestRequestHeaders) (string, string) {
+		response, err := testutils.RunTestRequest(mux, http.MethodPost, testEndpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		secret, _ := data["secret"].(string)
+		uri, _ := data["otpauthUri"].(string)
+		return secret, uri
+	}
+
+	generateCode := func(secret string) string {
+		code, err := auth.GenerateTOTP(secret, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return code
+	}
+
+	t.Run("should enable two-factor authentication", func(t *testing.T) {
+		testUser := createTestUser()
+		headers := generateHeaders(testUser.ID)
+
+		secret, uri := enroll(t, headers)
+		assert.NotEmpty(t, secret)
+		assert.True(t, strings.HasPrefix(uri, "otpauth://totp/"))
+		assert.Contains(t, uri, "secret="+secret)
+
+		response, err := testutils.RunTestRequest(mux, http.MethodGet, testEndpoint+"/qr", headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "image/png", response.Response.Header().Get("Content-Type"))
+
+		data := testutils.TestRequestData{"code": generateCode(secret)}
+		response, err = testutils.RunTestRequest(mux, http.MethodPost, testEndpoint+"/confirm", headers, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "Two-factor authentication enabled", response.GetMessage())
+
+		responseData, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		recoveryCodes, ok := responseData["recoveryCodes"].([]any)
+		assert.True(t, ok)
+		assert.Len(t, recoveryCodes, auth.RecoveryCodeCount)
+
+		twoFactor, err := appItems.App.Store.TwoFactor.Get(ctx, testUser.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, twoFactor.IsEnabled())
+
+		// The secret can't be fetched or replaced once it is confirmed.
+		response, err = testutils.RunTestRequest(mux, http.MethodGet, testEndpoint+"/qr", headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "No pending two-factor enrollment", response.GetMessage())
+
+		response, err = testutils.RunTestRequest(mux, http.MethodPost, testEndpoint, headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Two-factor authentication is already enabled", response.GetMessage())
+	})
+
+	t.Run("should replace an enrollment that was not confirmed", func(t *testing.T) {
+		headers := generateHeaders(createTestUser().ID)
+
+		oldSecret, _ := enroll(t, headers)
+		secret, _ := enroll(t, headers)
+		assert.NotEqual(t, oldSecret, secret)
+
+		data := testutils.TestRequestData{"code": generateCode(oldSecret)}
+		response, err := testutils.RunTestRequest(mux, http.MethodPost, testEndpoint+"/confirm", headers, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invalid two-factor authentication code", response.GetMessage())
+	})
+
+	t.Run("should not enable two-factor authentication without enrolling", func(t *testing.T) {
+		headers := generateHeaders(createTestUser().ID)
+
+		data := testutils.TestRequestData{"code": "123456"}
+		response, err := testutils.RunTestRequest(mux, http.MethodPost, testEndpoint+"/confirm", headers, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "No pending two-factor enrollment", response.GetMessage())
+	})
+
+	t.Run("should disable two-factor authentication", func(t *testing.T) {
+		testUser := createTestUser()
+		headers := generateHeaders(testUser.ID)
+
+		_, recoveryCodes, err := testutils.EnableTestTwoFactor(ctx, appItems.App.Store, testUser.ID, []byte(appItems.App.Config.SecretKey))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data := testutils.TestRequestData{"code": "abcde-fghij"}
+		response, err := testutils.RunTestRequest(mux, http.MethodPost, testEndpoint+"/disable", headers, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Invalid two-factor authentication code", response.GetMessage())
+
+		data = testutils.TestRequestData{"code": strings.ToUpper(recoveryCodes[0])}
+		response, err = testutils.RunTestRequest(mux, http.MethodPost, testEndpoint+"/disable", headers, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+		assert.Equal(t, "Two-factor authentication disabled", response.GetMessage())
+
+		response, err = testutils.RunTestRequest(mux, http.MethodPost, testEndpoint+"/disable", headers, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, "Two-factor authentication is not enabled", response.GetMessage())
+	})
+
+	t.Run("should store the secret encrypted", func(t *testing.T) {
+		testUser := createTestUser()
+		headers := generateHeaders(testUser.ID)
+
+		secret, _ := enroll(t, headers)
+
+		twoFactor, err := appItems.App.Store.TwoFactor.Get(ctx, testUser.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.NotEqual(t, secret, twoFactor.Secret)
+
+		decryptedSecret, err := auth.DecryptTOTPSecret(twoFactor.Secret, []byte(appItems.App.Config.SecretKey))
+		assert.Nil(t, err)
+		assert.Equal(t, secret, decryptedSecret)
+	})
+
+	t.Run("should not disable two-factor authentication after too many invalid codes", func(t *testing.T) {
+		testUser := createTestUser()
+		headers := generateHeaders(testUser.ID)
+
+		_, recoveryCodes, err := testutils.EnableTestTwoFactor(ctx, appItems.App.Store, testUser.ID, []byte(appItems.App.Config.SecretKey))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data := testutils.TestRequestData{"code": "abcde-fghij"}
+		for range 5 {
+			response, err := testutils.RunTestRequest(mux, http.MethodPost, testEndpoint+"/disable", headers, data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+			assert.Equal(t, "Invalid two-factor authentication code", response.GetMessage())
+		}
+
+		data = testutils.TestRequestData{"code": recoveryCodes[0]}
+		response, err := testutils.RunTestRequest(mux, http.MethodPost, testEndpoint+"/disable", headers, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+		assert.Equal(t, middleware.TooManyTwoFactorAttemptsMessage, response.GetMessage())
+
+		twoFactor, err := appItems.App.Store.TwoFactor.Get(ctx, testUser.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, twoFactor.IsEnabled())
+	})
+
+	t.Run("should not manage two-factor authentication without a token", func(t *testing.T) {
+		response, err := testutils.RunTestRequest(mux, http.MethodPost, testEndpoint, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+		assert.Equal(t, "unauthorized", response.GetMessage())
+	})
+}
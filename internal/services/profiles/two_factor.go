@@ -0,0 +1,302 @@
+package profiles
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/auth"
+	"github.com/KengoWada/meetup-clone/internal/middleware"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/KengoWada/meetup-clone/internal/utils"
+	"github.com/KengoWada/meetup-clone/internal/validate"
+	"github.com/skip2/go-qrcode"
+)
+
+// twoFactorIssuer is the name authenticator apps show codes for the
+// application under.
+const twoFactorIssuer = "MeetUp Clone"
+
+// twoFactorQRCodeSize is the width and height in pixels of enrollment QR
+// codes.
+const twoFactorQRCodeSize = 256
+
+var (
+	errTwoFactorEnabled    = errors.New("two-factor authentication is already enabled")
+	errTwoFactorNotEnabled = errors.New("two-factor authentication is not enabled")
+	errNoTwoFactorPending  = errors.New("no pending two-factor enrollment")
+	errInvalidTwoFactor    = errors.New("invalid two-factor code")
+)
+
+type twoFactorCodePayload struct {
+	Code string `json:"code" validate:"required"`
+}
+
+type twoFactorEnrollmentResponse struct {
+	Secret     string `json:"secret"`
+	OTPAuthURI string `json:"otpauthUri"`
+}
+
+// EnrollTwoFactor godoc
+//
+//	@Summary		Start enrolling in two-factor authentication
+//	@Description	Create a new TOTP secret for the user. Add it to an authenticator app with the otpauth URI or the QR code from /profiles/2fa/qr, then confirm a code to enable two-factor authentication. Starting again replaces a secret that was not confirmed
+//	@Tags			profiles
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	twoFactorEnrollmentResponse	"enrollment successfully started"
+//	@Failure		400	{object}	response.DocsErrorResponse
+//	@Failure		401	{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		500	{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/profiles/2fa [post]
+func (h *Handler) enrollTwoFactor(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+
+	errorMessage := response.ErrorResponse{Message: "Two-factor authentication is already enabled"}
+
+	secret, err := auth.GenerateTOTPSecret()
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	encryptedSecret, err := auth.EncryptTOTPSecret(secret, []byte(cfg.SecretKey))
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	twoFactor := &models.TwoFactor{UserID: user.ID, Secret: encryptedSecret}
+	if err := h.store.TwoFactor.CreatePending(ctx, twoFactor); err != nil {
+		switch err {
+		case store.ErrNotFound:
+			response.ErrorResponseBadRequest(w, r, errTwoFactorEnabled, errorMessage)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	data := twoFactorEnrollmentResponse{
+		Secret:     secret,
+		OTPAuthURI: auth.TOTPURI(secret, twoFactorIssuer, user.Email),
+	}
+	response.SuccessResponseOK(w, "", data)
+}
+
+// GetTwoFactorQRCode godoc
+//
+//	@Summary		Get the QR code of a two-factor enrollment
+//	@Description	Get the otpauth URI of the user's pending two-factor enrollment as a QR code to scan with an authenticator app
+//	@Tags			profiles
+//	@Produce		png
+//	@Success		200	{file}		file	"enrollment QR code"
+//	@Failure		400	{object}	response.DocsErrorResponse
+//	@Failure		401	{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		500	{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/profiles/2fa/qr [get]
+func (h *Handler) getTwoFactorQRCode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+
+	twoFactor, ok := h.getPendingTwoFactor(w, r, user.ID)
+	if !ok {
+		return
+	}
+
+	secret, err := auth.DecryptTOTPSecret(twoFactor.Secret, []byte(cfg.SecretKey))
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	uri := auth.TOTPURI(secret, twoFactorIssuer, user.Email)
+	image, err := qrcode.Encode(uri, qrcode.Medium, twoFactorQRCodeSize)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	response.SuccessResponsePNG(w, image)
+}
+
+// ConfirmTwoFactor godoc
+//
+//	@Summary		Enable two-factor authentication
+//	@Description	Confirm a code from the authenticator app to enable two-factor authentication. Returns one-time recovery codes to log in with when the app is not available. The recovery codes are only shown once
+//	@Tags			profiles
+//	@Accept			json
+//	@Produce		json
+//	@Param			payload	body		twoFactorCodePayload	true	"confirm two-factor payload"
+//	@Success		200		{object}	[]string				"two-factor authentication successfully enabled"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/profiles/2fa/confirm [post]
+func (h *Handler) confirmTwoFactor(w http.ResponseWriter, r *http.Request) {
+	payload, ok := readTwoFactorCodePayload(w, r)
+	if !ok {
+		return
+	}
+
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+
+	twoFactor, ok := h.getPendingTwoFactor(w, r, user.ID)
+	if !ok {
+		return
+	}
+
+	errorMessage := response.ErrorResponse{Message: "Invalid two-factor authentication code"}
+
+	secret, err := auth.DecryptTOTPSecret(twoFactor.Secret, []byte(cfg.SecretKey))
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	step, ok := auth.ValidateTOTP(secret, payload.Code, time.Now())
+	if !ok {
+		response.ErrorResponseBadRequest(w, r, errInvalidTwoFactor, errorMessage)
+		return
+	}
+
+	codes, hashes, err := auth.GenerateRecoveryCodes()
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	if err := h.store.TwoFactor.Enable(ctx, twoFactor, step, hashes); err != nil {
+		switch err {
+		case store.ErrNotFound:
+			response.ErrorResponseBadRequest(w, r, errInvalidTwoFactor, errorMessage)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	data := map[string]any{"recoveryCodes": codes}
+	response.SuccessResponseOK(w, "Two-factor authentication enabled", data)
+}
+
+// DisableTwoFactor godoc
+//
+//	@Summary		Disable two-factor authentication
+//	@Description	Disable two-factor authentication with a code from the authenticator app or a recovery code. The user's recovery codes stop working. After 5 invalid codes in a row the user has to wait 15 minutes before trying again
+//	@Tags			profiles
+//	@Accept			json
+//	@Produce		json
+//	@Param			payload	body		twoFactorCodePayload				true	"disable two-factor payload"
+//	@Success		200		{object}	response.DocsResponseMessageOnly	"two-factor authentication successfully disabled"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/profiles/2fa/disable [post]
+func (h *Handler) disableTwoFactor(w http.ResponseWriter, r *http.Request) {
+	payload, ok := readTwoFactorCodePayload(w, r)
+	if !ok {
+		return
+	}
+
+	ctx := r.Context()
+	user, _ := ctx.Value(internal.UserCtx).(*models.User)
+
+	errorMessage := response.ErrorResponse{Message: "Two-factor authentication is not enabled"}
+
+	twoFactor, err := h.store.TwoFactor.Get(ctx, user.ID)
+	if err != nil {
+		switch err {
+		case store.ErrNotFound:
+			response.ErrorResponseBadRequest(w, r, errTwoFactorNotEnabled, errorMessage)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	if !twoFactor.IsEnabled() {
+		response.ErrorResponseBadRequest(w, r, errTwoFactorNotEnabled, errorMessage)
+		return
+	}
+
+	ok, err = middleware.VerifyTwoFactorCode(ctx, h.store, twoFactor, payload.Code)
+	if err != nil {
+		switch err {
+		case middleware.ErrTooManyTwoFactorAttempts:
+			errorMessage := response.ErrorResponse{Message: middleware.TooManyTwoFactorAttemptsMessage}
+			response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return
+	}
+
+	if !ok {
+		errorMessage := response.ErrorResponse{Message: "Invalid two-factor authentication code"}
+		response.ErrorResponseBadRequest(w, r, errInvalidTwoFactor, errorMessage)
+		return
+	}
+
+	if err := h.store.TwoFactor.Disable(ctx, user.ID); err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	response.SuccessResponseOK(w, "Two-factor authentication disabled", nil)
+}
+
+// getPendingTwoFactor fetches the user's two-factor enrollment that has not
+// been confirmed yet. It writes an error response and returns false if
+// there is none.
+func (h *Handler) getPendingTwoFactor(w http.ResponseWriter, r *http.Request, userID int64) (*models.TwoFactor, bool) {
+	errorMessage := response.ErrorResponse{Message: "No pending two-factor enrollment"}
+
+	twoFactor, err := h.store.TwoFactor.Get(r.Context(), userID)
+	if err != nil {
+		switch err {
+		case store.ErrNotFound:
+			response.ErrorResponseBadRequest(w, r, errNoTwoFactorPending, errorMessage)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return nil, false
+	}
+
+	if twoFactor.IsEnabled() {
+		response.ErrorResponseBadRequest(w, r, errNoTwoFactorPending, errorMessage)
+		return nil, false
+	}
+
+	return twoFactor, true
+}
+
+func readTwoFactorCodePayload(w http.ResponseWriter, r *http.Request) (*twoFactorCodePayload, bool) {
+	var payload twoFactorCodePayload
+	if err := utils.ReadJSON(w, r, &payload); err != nil {
+		response.ErrorResponseInvalidJSON(w, r, err)
+		return nil, false
+	}
+
+	if errResponse, err := validate.ValidatePayload(payload, validate.FieldErrorMessages{}); err != nil {
+		switch err {
+		case validate.ErrFailedValidation:
+			errorMessage := response.NewValidationErrorResponse(errResponse)
+			response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		default:
+			response.ErrorResponseInternalServerErr(w, r, err)
+		}
+		return nil, false
+	}
+
+	return &payload, true
+}
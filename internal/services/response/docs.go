@@ -28,12 +28,23 @@ type DocsErrorResponseForbidden struct {
 }
 
 // DocsSuccessResponseLoginUser represents an example success response for a user login
-// in Swagger documentation. It includes a token that would typically be returned upon
-// successful authentication. This struct is used to provide example success responses
+// in Swagger documentation. It includes the access and refresh tokens that would typically
+// be returned upon successful authentication. This struct is used to provide example success responses
 // in API documentation generated by Swagger, specifically for the login process.
 type DocsSuccessResponseLoginUser struct {
 	Data struct {
-		Token string `json:"token" example:"jwt.access.token"`
+		Token        string `json:"token" example:"jwt.access.token"`
+		RefreshToken string `json:"refreshToken" example:"opaque-refresh-token"`
+	} `json:"data"`
+}
+
+// DocsSuccessResponseLoginChallenge represents an example response for a user login
+// when the user has two-factor authentication enabled. It includes the challenge token
+// the user finishes logging in with along with a code from their authenticator app.
+type DocsSuccessResponseLoginChallenge struct {
+	Message string `json:"message" example:"Two-factor authentication required"`
+	Data    struct {
+		ChallengeToken string `json:"challengeToken" example:"opaque-challenge-token"`
 	} `json:"data"`
 }
 
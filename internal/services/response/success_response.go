@@ -23,3 +23,27 @@ func SuccessResponseOK(w http.ResponseWriter, message string, data any) {
 	response := SuccessResponse{Message: message, Data: data}
 	utils.WriteJSON(w, http.StatusOK, response)
 }
+
+// SuccessResponseAccepted returns a success response with a status of HTTP 202 (Accepted).
+// It is used when a request was valid but another step is needed to finish it,
+// such as logging in with two-factor authentication enabled.
+func SuccessResponseAccepted(w http.ResponseWriter, message string, data any) {
+	response := SuccessResponse{Message: message, Data: data}
+	utils.WriteJSON(w, http.StatusAccepted, response)
+}
+
+// SuccessResponseCalendar returns an iCalendar document with a status of
+// HTTP 200 (OK). It is used for feeds that are read by calendar clients
+// rather than the frontend.
+func SuccessResponseCalendar(w http.ResponseWriter, calendar []byte) {
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(calendar)
+}
+
+// SuccessResponsePNG returns a PNG image with a status of HTTP 200 (OK).
+func SuccessResponsePNG(w http.ResponseWriter, image []byte) {
+	w.Header().Set("Content-Type", "image/png")
+	w.WriteHeader(http.StatusOK)
+	w.Write(image)
+}
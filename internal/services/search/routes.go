@@ -0,0 +1,26 @@
+package search
+
+import (
+	"net/http"
+
+	"github.com/KengoWada/meetup-clone/internal/middleware"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/go-chi/chi/v5"
+)
+
+type Handler struct {
+	store store.Store
+}
+
+func NewHandler(store store.Store) *Handler {
+	return &Handler{store}
+}
+
+func (h *Handler) RegisterRoutes() http.Handler {
+	mux := chi.NewRouter()
+	mux.Use(middleware.AuthenticatedRoute)
+
+	mux.Get("/", h.search)
+
+	return mux
+}
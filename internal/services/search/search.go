@@ -0,0 +1,93 @@
+package search
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/services/response"
+)
+
+const (
+	// maxQueryLength is the longest text that can be searched for.
+	maxQueryLength = 200
+	// defaultLimit is how many results are returned when the client does
+	// not say.
+	defaultLimit = 20
+	// maxLimit is the most results a client can ask for.
+	maxLimit = 50
+)
+
+// Search godoc
+//
+//	@Summary		Search organizations, events and users
+//	@Description	Search organizations by name and description, published events by title and description, and users by username. Results are ranked best match first and have a snippet of the matching text with the matching words wrapped in <mark> tags
+//	@Tags			search
+//	@Accept			json
+//	@Produce		json
+//	@Param			q		query		string					true	"text to search for. Supports quoted phrases, OR, and - to leave out words"
+//	@Param			type	query		string					false	"only return results of this type"	Enums(organizations, events, users)
+//	@Param			limit	query		int						false	"number of results, defaults to 20 and is at most 50"
+//	@Success		200		{object}	[]models.SearchResult	"search results successfully fetched"
+//	@Failure		400		{object}	response.DocsErrorResponse
+//	@Failure		401		{object}	response.DocsErrorResponseUnauthorized
+//	@Failure		500		{object}	response.DocsErrorResponseInternalServerErr
+//	@Security		ApiKeyAuth
+//	@Router			/search [get]
+func (h *Handler) search(w http.ResponseWriter, r *http.Request) {
+	text, types, limit, err := parseSearchQuery(r)
+	if err != nil {
+		errorMessage := response.ErrorResponse{Message: err.Error()}
+		response.ErrorResponseBadRequest(w, r, err, errorMessage)
+		return
+	}
+
+	results, err := h.store.Search.Search(r.Context(), text, types, limit)
+	if err != nil {
+		response.ErrorResponseInternalServerErr(w, r, err)
+		return
+	}
+
+	if results == nil {
+		results = []*models.SearchResult{}
+	}
+
+	response.SuccessResponseOK(w, "", map[string]any{"results": results})
+}
+
+func parseSearchQuery(r *http.Request) (string, []models.SearchType, int, error) {
+	query := r.URL.Query()
+
+	text := strings.TrimSpace(query.Get("q"))
+	if text == "" {
+		return "", nil, 0, errors.New("Search text is required")
+	}
+
+	if utf8.RuneCountInString(text) > maxQueryLength {
+		return "", nil, 0, errors.New("Search text should be at most 200 characters")
+	}
+
+	types := models.SearchTypes
+	if value := query.Get("type"); value != "" {
+		searchType := models.SearchType(value)
+		if !slices.Contains(models.SearchTypes, searchType) {
+			return "", nil, 0, errors.New("Type should be one of organizations, events or users")
+		}
+		types = []models.SearchType{searchType}
+	}
+
+	limit := defaultLimit
+	if value := query.Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > maxLimit {
+			return "", nil, 0, errors.New("Limit should be a number between 1 and 50")
+		}
+		limit = parsed
+	}
+
+	return text, types, limit, nil
+}
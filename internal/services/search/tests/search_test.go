@@ -0,0 +1,206 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/app"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/utils/testutils"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearch(t *testing.T) {
+	testEndpoint := func(query url.Values) string {
+		return "/v1/search?" + query.Encode()
+	}
+	testMethod := http.MethodGet
+
+	appItems, err := app.NewApplication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	appItems.App.Store = testutils.NewTestStore(t, appItems.DB)
+
+	mux := appItems.App.Mount()
+	ctx := context.Background()
+
+	createTestUser := func(activate bool) *models.User {
+		testUserData := testutils.NewTestUserData(activate)
+		user, userProfile, err := testUserData.CreateTestUser(ctx, appItems.App.Store, models.UserClientRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user.UserProfile = userProfile
+		return user
+	}
+
+	createTestOrg := func(isActive bool, userID int64) *models.Organization {
+		role := &models.Role{Name: faker.Username(options.WithGenerateUniqueValues(true)), Permissions: internal.Permissions}
+		org, err := testutils.CreateTestOrganization(ctx, appItems.App.Store, isActive, role, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return org
+	}
+
+	generateToken := func(ID int64, isValid bool) string {
+		token, err := testutils.GenerateTesAuthToken(ctx, appItems.App.Store, appItems.App.Authenticator, appItems.App.Config.AuthConfig, isValid, ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	search := func(userID int64, query url.Values) []map[string]any {
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(userID, true)}
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(query), headers, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusOK, response.StatusCode())
+
+		data, ok := response.GetData()
+		if !ok {
+			t.Fatal("failed to convert response data to map")
+		}
+
+		items, ok := data["results"].([]any)
+		if !ok {
+			t.Fatal("failed to convert results to slice")
+		}
+
+		results := make([]map[string]any, 0, len(items))
+		for _, item := range items {
+			result, _ := item.(map[string]any)
+			results = append(results, result)
+		}
+
+		return results
+	}
+
+	resultIDs := func(results []map[string]any, searchType models.SearchType) []int64 {
+		var ids []int64
+		for _, result := range results {
+			if result["type"] == string(searchType) {
+				ids = append(ids, int64(result["id"].(float64)))
+			}
+		}
+
+		return ids
+	}
+
+	t.Run("should search organizations", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, testUser.UserProfile.ID)
+		inactiveOrg := createTestOrg(false, testUser.UserProfile.ID)
+
+		word := strings.ToLower(faker.Username(options.WithGenerateUniqueValues(true)))
+		for _, org := range []*models.Organization{testOrg, inactiveOrg} {
+			org.Description = "A group for people who love " + word
+			if err := appItems.App.Store.Organizations.Update(ctx, org); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		results := search(testUser.ID, url.Values{"q": {word}})
+		assert.Equal(t, []int64{testOrg.ID}, resultIDs(results, models.SearchOrganizations))
+		assert.Equal(t, testOrg.Name, results[0]["title"])
+		assert.Contains(t, results[0]["snippet"], "<mark>"+word+"</mark>")
+	})
+
+	t.Run("should escape snippets", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, testUser.UserProfile.ID)
+
+		word := strings.ToLower(faker.Username(options.WithGenerateUniqueValues(true)))
+		testOrg.Description = "<script>alert(1)</script> " + word
+		if err := appItems.App.Store.Organizations.Update(ctx, testOrg); err != nil {
+			t.Fatal(err)
+		}
+
+		results := search(testUser.ID, url.Values{"q": {word}, "type": {"organizations"}})
+		if assert.Len(t, results, 1) {
+			assert.NotContains(t, results[0]["snippet"], "<script>")
+			assert.Contains(t, results[0]["snippet"], "&lt;script&gt;")
+		}
+	})
+
+	t.Run("should search published events", func(t *testing.T) {
+		testUser := createTestUser(true)
+		testOrg := createTestOrg(true, testUser.UserProfile.ID)
+
+		publishedEvent, err := testutils.CreateTestPublishedEvent(ctx, appItems.App.Store, false, testOrg.ID, testUser.UserProfile.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		draftEvent, err := testutils.CreateTestEvent(ctx, appItems.App.Store, false, testOrg.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		word := strings.ToLower(faker.Username(options.WithGenerateUniqueValues(true)))
+		for _, event := range []*models.Event{publishedEvent, draftEvent} {
+			event.Title = "Monthly " + word + " meetup"
+			if err := appItems.App.Store.Events.Update(ctx, event); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		results := search(testUser.ID, url.Values{"q": {word}, "type": {"events"}})
+		assert.Equal(t, []int64{publishedEvent.ID}, resultIDs(results, models.SearchEvents))
+	})
+
+	t.Run("should search users", func(t *testing.T) {
+		testUser := createTestUser(true)
+		inactiveUser := createTestUser(false)
+
+		results := search(testUser.ID, url.Values{"q": {testUser.UserProfile.Username}, "type": {"users"}})
+		assert.Equal(t, []int64{testUser.UserProfile.ID}, resultIDs(results, models.SearchUsers))
+
+		results = search(testUser.ID, url.Values{"q": {inactiveUser.UserProfile.Username}, "type": {"users"}})
+		assert.Empty(t, results)
+	})
+
+	t.Run("should not search with invalid query", func(t *testing.T) {
+		testUser := createTestUser(true)
+		headers := testutils.TestRequestHeaders{"Authorization": "Bearer " + generateToken(testUser.ID, true)}
+
+		testCases := []struct {
+			query        url.Values
+			errorMessage string
+		}{
+			{url.Values{"q": {"  "}}, "Search text is required"},
+			{url.Values{"q": {strings.Repeat("a", 201)}}, "Search text should be at most 200 characters"},
+			{url.Values{"q": {"golang"}, "type": {"roles"}}, "Type should be one of organizations, events or users"},
+			{url.Values{"q": {"golang"}, "limit": {"0"}}, "Limit should be a number between 1 and 50"},
+		}
+
+		for _, testCase := range testCases {
+			response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(testCase.query), headers, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+			assert.Equal(t, testCase.errorMessage, response.GetMessage())
+		}
+	})
+
+	t.Run("should not search when not authenticated", func(t *testing.T) {
+		response, err := testutils.RunTestRequest(mux, testMethod, testEndpoint(url.Values{"q": {"golang"}}), nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode())
+	})
+}
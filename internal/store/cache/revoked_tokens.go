@@ -0,0 +1,50 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/bradfitz/gomemcache/memcache"
+)
+
+type RevokedTokenStore struct {
+	cacheDB *memcache.Client
+}
+
+func (s *RevokedTokenStore) getCacheKey(jti string) string {
+	return fmt.Sprintf("%s:%s", CacheKeyRevokedToken, jti)
+}
+
+// Get reports whether the token with the jti is revoked. It returns nil if
+// the token is not in the cache.
+func (s *RevokedTokenStore) Get(jti string) (*bool, error) {
+	item, err := getFromCache(s.cacheDB, s.getCacheKey(jti))
+	if err != nil {
+		return nil, err
+	}
+
+	if item == nil {
+		return nil, nil
+	}
+
+	isRevoked := string(item.Value) == "1"
+	return &isRevoked, nil
+}
+
+// Set caches whether the token with the jti is revoked for ttl seconds.
+func (s *RevokedTokenStore) Set(jti string, isRevoked bool, ttl int32) error {
+	value := "0"
+	if isRevoked {
+		value = "1"
+	}
+
+	item := &memcache.Item{
+		Key:        s.getCacheKey(jti),
+		Value:      []byte(value),
+		Expiration: ttl,
+	}
+	if err := s.cacheDB.Set(item); err != nil {
+		return err
+	}
+
+	return nil
+}
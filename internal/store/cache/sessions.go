@@ -0,0 +1,62 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/bradfitz/gomemcache/memcache"
+)
+
+type SessionStore struct {
+	cacheDB *memcache.Client
+}
+
+func (s *SessionStore) getCacheKey(ID int64) string {
+	return fmt.Sprintf("%s:%d", CacheKeySession, ID)
+}
+
+func (s *SessionStore) Get(ID int64) (*models.UserSession, error) {
+	item, err := getFromCache(s.cacheDB, s.getCacheKey(ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if item == nil {
+		return nil, nil
+	}
+
+	var session models.UserSession
+	if err := json.Unmarshal(item.Value, &session); err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+func (s *SessionStore) Set(session *models.UserSession) error {
+	sessionBytes, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	sessionItem := &memcache.Item{
+		Key:        s.getCacheKey(session.ID),
+		Value:      sessionBytes,
+		Expiration: CacheTTLSession,
+	}
+	if err := s.cacheDB.Set(sessionItem); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *SessionStore) Delete(ID int64) error {
+	err := s.cacheDB.Delete(s.getCacheKey(ID))
+	if err != nil && err != memcache.ErrCacheMiss {
+		return err
+	}
+
+	return nil
+}
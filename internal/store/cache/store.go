@@ -17,6 +17,12 @@ const (
 
 	CacheKeyOrgMember string = "org_member"
 	CacheTTLOrgMember int32  = 60 * 60 // 1 hour in seconds
+
+	CacheKeyRevokedToken string = "revoked_token"
+	CacheTTLRevokedToken int32  = 60 * 5 // 5 minutes in seconds
+
+	CacheKeySession string = "session"
+	CacheTTLSession int32  = 60 * 60 // 1 hour in seconds
 )
 
 type CacheKey string
@@ -42,6 +48,15 @@ type Store struct {
 		Set(member *models.OrganizationMember) error
 		Delete(userID, orgID int64) error
 	}
+	RevokedTokens interface {
+		Get(jti string) (*bool, error)
+		Set(jti string, isRevoked bool, ttl int32) error
+	}
+	Sessions interface {
+		Get(ID int64) (*models.UserSession, error)
+		Set(session *models.UserSession) error
+		Delete(ID int64) error
+	}
 }
 
 func NewCacheStore(memcached *memcache.Client) Store {
@@ -50,6 +65,8 @@ func NewCacheStore(memcached *memcache.Client) Store {
 		Organizations:       &OrganizationStore{cacheDB: memcached},
 		Roles:               &RoleStore{cacheDB: memcached},
 		OrganizationMembers: &OrganizationMemberStore{cacheDB: memcached},
+		RevokedTokens:       &RevokedTokenStore{cacheDB: memcached},
+		Sessions:            &SessionStore{cacheDB: memcached},
 	}
 }
 
@@ -0,0 +1,113 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+)
+
+type CalendarFeedStore struct {
+	db *sql.DB
+}
+
+// Create revokes the user's current feed, if they have one, and creates a
+// new one in its place within a single transaction.
+func (s *CalendarFeedStore) Create(ctx context.Context, feed *models.CalendarFeed) error {
+	return WithTx(s.db, ctx, func(tx *sql.Tx) error {
+		if err := revokeCalendarFeedsTx(ctx, tx, feed.UserProfileID); err != nil {
+			return err
+		}
+
+		query := `
+			INSERT INTO calendar_feeds(user_id)
+			VALUES($1)
+			RETURNING id, version, created_at, updated_at, deleted_at
+		`
+		ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+		defer cancel()
+
+		return tx.QueryRowContext(ctx, query, feed.UserProfileID).Scan(
+			&feed.ID,
+			&feed.Version,
+			&feed.CreatedAt,
+			&feed.UpdatedAt,
+			&feed.DeletedAt,
+		)
+	})
+}
+
+func (s *CalendarFeedStore) Get(ctx context.Context, isDeleted bool, fields []string, values []any) (*models.CalendarFeed, error) {
+	query := fmt.Sprintf(
+		"SELECT id, user_id, version, created_at, updated_at, deleted_at FROM calendar_feeds WHERE %s",
+		generateQueryConditions(isDeleted, fields),
+	)
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var feed models.CalendarFeed
+	err := s.db.QueryRowContext(ctx, query, values...).Scan(
+		&feed.ID,
+		&feed.UserProfileID,
+		&feed.Version,
+		&feed.CreatedAt,
+		&feed.UpdatedAt,
+		&feed.DeletedAt,
+	)
+
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return nil, ErrNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return &feed, nil
+}
+
+func (s *CalendarFeedStore) SoftDelete(ctx context.Context, feed *models.CalendarFeed) error {
+	query := `
+		UPDATE calendar_feeds
+		SET deleted_at = $1, version = version + 1
+		WHERE id = $2 AND version = $3
+		RETURNING version, updated_at, deleted_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	values := []any{time.Now().UTC().Format(internal.DateTimeFormat), feed.ID, feed.Version}
+	err := s.db.QueryRowContext(ctx, query, values...).Scan(
+		&feed.Version,
+		&feed.UpdatedAt,
+		&feed.DeletedAt,
+	)
+
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+func revokeCalendarFeedsTx(ctx context.Context, tx *sql.Tx, userID int64) error {
+	query := `
+		UPDATE calendar_feeds
+		SET deleted_at = $1, version = version + 1
+		WHERE user_id = $2 AND deleted_at IS NULL
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	_, err := tx.ExecContext(ctx, query, time.Now().UTC().Format(internal.DateTimeFormat), userID)
+	return err
+}
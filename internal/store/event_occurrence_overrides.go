@@ -0,0 +1,107 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/lib/pq"
+)
+
+type EventOccurrenceOverrideStore struct {
+	db *sql.DB
+}
+
+// Save creates the override for the occurrence, or replaces the existing
+// one if the occurrence was already overridden.
+func (s *EventOccurrenceOverrideStore) Save(ctx context.Context, override *models.EventOccurrenceOverride) error {
+	query := `
+		INSERT INTO event_occurrence_overrides(
+			event_id, occurrence_start, title, description, start_time, end_time,
+			location, online_url, capacity
+		)
+		VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (event_id, occurrence_start) DO UPDATE
+		SET title = EXCLUDED.title, description = EXCLUDED.description,
+			start_time = EXCLUDED.start_time, end_time = EXCLUDED.end_time,
+			location = EXCLUDED.location, online_url = EXCLUDED.online_url,
+			capacity = EXCLUDED.capacity, deleted_at = NULL,
+			version = event_occurrence_overrides.version + 1
+		RETURNING id, occurrence_start, start_time, end_time, version, created_at, updated_at, deleted_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	return s.db.QueryRowContext(
+		ctx,
+		query,
+		override.EventID,
+		override.OccurrenceStart,
+		override.Title,
+		override.Description,
+		override.StartTime,
+		override.EndTime,
+		override.Location,
+		override.OnlineURL,
+		override.Capacity,
+	).Scan(
+		&override.ID,
+		&override.OccurrenceStart,
+		&override.StartTime,
+		&override.EndTime,
+		&override.Version,
+		&override.CreatedAt,
+		&override.UpdatedAt,
+		&override.DeletedAt,
+	)
+}
+
+func (s *EventOccurrenceOverrideStore) GetByEventID(ctx context.Context, eventID int64) ([]*models.EventOccurrenceOverride, error) {
+	return s.GetByEventIDs(ctx, []int64{eventID})
+}
+
+func (s *EventOccurrenceOverrideStore) GetByEventIDs(ctx context.Context, eventIDs []int64) ([]*models.EventOccurrenceOverride, error) {
+	query := `
+		SELECT id, event_id, occurrence_start, title, description, start_time, end_time,
+			location, online_url, capacity, version, created_at, updated_at, deleted_at
+		FROM event_occurrence_overrides
+		WHERE event_id = ANY($1) AND deleted_at IS NULL
+		ORDER BY event_id ASC, occurrence_start ASC
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, query, pq.Array(eventIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var overrides []*models.EventOccurrenceOverride
+	for rows.Next() {
+		var override models.EventOccurrenceOverride
+		err := rows.Scan(
+			&override.ID,
+			&override.EventID,
+			&override.OccurrenceStart,
+			&override.Title,
+			&override.Description,
+			&override.StartTime,
+			&override.EndTime,
+			&override.Location,
+			&override.OnlineURL,
+			&override.Capacity,
+			&override.Version,
+			&override.CreatedAt,
+			&override.UpdatedAt,
+			&override.DeletedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		overrides = append(overrides, &override)
+	}
+
+	return overrides, nil
+}
@@ -0,0 +1,477 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/lib/pq"
+)
+
+const eventRSVPColumns = `
+	id, event_id, user_id, occurrence_start, status, waitlist_position, ticket,
+	checked_in_at, checked_in_by, version, created_at, updated_at, deleted_at
+`
+
+const createEventRSVPQuery = `
+	INSERT INTO event_rsvps(event_id, user_id, occurrence_start, status, waitlist_position, ticket)
+	VALUES($1, $2, $3, $4, $5, $6)
+	RETURNING id, occurrence_start, version, created_at, updated_at, deleted_at
+`
+
+type EventRSVPStore struct {
+	db *sql.DB
+}
+
+func (s *EventRSVPStore) Create(ctx context.Context, rsvp *models.EventRSVP) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	values := createEventRSVPValues(rsvp)
+	return s.db.QueryRowContext(ctx, createEventRSVPQuery, values...).Scan(
+		&rsvp.ID,
+		&rsvp.OccurrenceStart,
+		&rsvp.Version,
+		&rsvp.CreatedAt,
+		&rsvp.UpdatedAt,
+		&rsvp.DeletedAt,
+	)
+}
+
+func (s *EventRSVPStore) Get(ctx context.Context, isDeleted bool, fields []string, values []any) (*models.EventRSVP, error) {
+	query := fmt.Sprintf("SELECT %s FROM event_rsvps WHERE %s", eventRSVPColumns, generateQueryConditions(isDeleted, fields))
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rsvp, err := scanEventRSVP(s.db.QueryRowContext(ctx, query, values...))
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return nil, ErrNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return rsvp, nil
+}
+
+// GetByEventID returns the RSVPs to the event. For recurring events only the
+// RSVPs to the occurrence starting at occurrenceStart are returned.
+func (s *EventRSVPStore) GetByEventID(ctx context.Context, eventID int64, occurrenceStart *string) ([]*models.EventAttendee, error) {
+	query := `
+		SELECT p.id, p.username, p.profile_pic, r.status, r.waitlist_position, r.created_at,
+			r.checked_in_at
+		FROM event_rsvps r
+		INNER JOIN user_profiles p
+			ON p.id = r.user_id
+		WHERE r.event_id = $1 AND r.occurrence_start IS NOT DISTINCT FROM $2::TIMESTAMPTZ
+			AND r.deleted_at IS NULL
+		ORDER BY r.status ASC, r.waitlist_position ASC NULLS FIRST, r.created_at ASC
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, query, eventID, occurrenceStart)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var attendees []*models.EventAttendee
+	for rows.Next() {
+		var attendee models.EventAttendee
+		err := rows.Scan(
+			&attendee.UserProfileID,
+			&attendee.Username,
+			&attendee.ProfilePic,
+			&attendee.Status,
+			&attendee.WaitlistPosition,
+			&attendee.RespondedAt,
+			&attendee.CheckedInAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		attendees = append(attendees, &attendee)
+	}
+
+	return attendees, nil
+}
+
+// Respond records a user's response to an event. The event row is locked
+// for the duration of the transaction so that concurrent responses can not
+// go past the event's capacity. Users who want to go to a full event are
+// added to the end of the waitlist. When a user gives up their spot, the
+// first user on the waitlist takes it and is sent the notification. Each
+// occurrence of a recurring event has its own capacity and waitlist.
+func (s *EventRSVPStore) Respond(ctx context.Context, rsvp *models.EventRSVP, notification *models.Notification) error {
+	return WithTx(s.db, ctx, func(tx *sql.Tx) error {
+		occurrence := eventOccurrence{rsvp.EventID, rsvp.OccurrenceStart}
+		capacity, err := lockEventTx(ctx, tx, occurrence)
+		if err != nil {
+			return err
+		}
+
+		existing, err := getEventRSVPForUpdateTx(ctx, tx, occurrence, rsvp.UserProfileID)
+		if err != nil && err != ErrNotFound {
+			return err
+		}
+
+		rsvp.WaitlistPosition = nil
+		if rsvp.Status == models.RSVPGoing {
+			// Users who are already going or waiting in line keep their spot.
+			if existing != nil && (existing.Status == models.RSVPGoing || existing.Status == models.RSVPWaitlisted) {
+				*rsvp = *existing
+				return nil
+			}
+
+			going, err := countGoingTx(ctx, tx, occurrence)
+			if err != nil {
+				return err
+			}
+
+			if going >= capacity {
+				position, err := nextWaitlistPositionTx(ctx, tx, occurrence)
+				if err != nil {
+					return err
+				}
+
+				rsvp.Status = models.RSVPWaitlisted
+				rsvp.WaitlistPosition = &position
+			}
+		}
+
+		if existing == nil {
+			return createEventRSVPTx(ctx, tx, rsvp)
+		}
+
+		rsvp.ID = existing.ID
+		rsvp.CreatedAt = existing.CreatedAt
+		if err := updateEventRSVPTx(ctx, tx, rsvp); err != nil {
+			return err
+		}
+
+		switch existing.Status {
+		case models.RSVPGoing:
+			if rsvp.Status != models.RSVPGoing {
+				return promoteWaitlistTx(ctx, tx, occurrence, notification)
+			}
+		case models.RSVPWaitlisted:
+			if rsvp.Status != models.RSVPWaitlisted {
+				return shiftWaitlistTx(ctx, tx, occurrence, *existing.WaitlistPosition)
+			}
+		}
+
+		return nil
+	})
+}
+
+func createEventRSVPTx(ctx context.Context, tx *sql.Tx, rsvp *models.EventRSVP) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	return tx.QueryRowContext(ctx, createEventRSVPQuery, createEventRSVPValues(rsvp)...).Scan(
+		&rsvp.ID,
+		&rsvp.OccurrenceStart,
+		&rsvp.Version,
+		&rsvp.CreatedAt,
+		&rsvp.UpdatedAt,
+		&rsvp.DeletedAt,
+	)
+}
+
+// CheckIn records that the user was let into the event by the member. It
+// is safe to call more than once, only the first check in is recorded.
+func (s *EventRSVPStore) CheckIn(ctx context.Context, rsvp *models.EventRSVP, memberID int64) error {
+	query := `
+		UPDATE event_rsvps
+		SET checked_in_at = COALESCE(checked_in_at, $1),
+			checked_in_by = COALESCE(checked_in_by, $2),
+			version = CASE WHEN checked_in_at IS NULL THEN version + 1 ELSE version END
+		WHERE id = $3 AND deleted_at IS NULL
+		RETURNING checked_in_at, checked_in_by, version, updated_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	values := []any{time.Now().UTC().Format(internal.DateTimeFormat), memberID, rsvp.ID}
+	err := s.db.QueryRowContext(ctx, query, values...).Scan(
+		&rsvp.CheckedInAt,
+		&rsvp.CheckedInBy,
+		&rsvp.Version,
+		&rsvp.UpdatedAt,
+	)
+
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetByUserID returns the user's RSVPs to published events that they are
+// going to, might go to, or are waitlisted for, along with the events.
+func (s *EventRSVPStore) GetByUserID(ctx context.Context, userID int64) ([]*models.EventRSVP, error) {
+	query := `
+		SELECT r.id, r.event_id, r.user_id, r.occurrence_start, r.status,
+			r.waitlist_position, r.ticket, r.checked_in_at, r.checked_in_by,
+			r.version, r.created_at, r.updated_at, r.deleted_at,
+			e.id, e.org_id, e.title, e.description, e.start_time, e.end_time,
+			e.location, e.online_url, e.venue_id, e.capacity, e.published_at, e.published_by,
+			e.cancelled_at, e.cancellation_reason, e.rrule, e.exdates, e.topics,
+			e.version, e.created_at, e.updated_at, e.deleted_at
+		FROM event_rsvps r
+		JOIN events e ON e.id = r.event_id
+		WHERE r.user_id = $1 AND r.status = ANY($2) AND r.deleted_at IS NULL
+			AND e.published_at IS NOT NULL AND e.deleted_at IS NULL
+		ORDER BY COALESCE(r.occurrence_start, e.start_time) ASC, r.id ASC
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	statuses := []string{string(models.RSVPGoing), string(models.RSVPMaybe), string(models.RSVPWaitlisted)}
+	rows, err := s.db.QueryContext(ctx, query, userID, pq.Array(statuses))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rsvps []*models.EventRSVP
+	for rows.Next() {
+		rsvp := models.EventRSVP{Event: &models.Event{}}
+		dest := []any{
+			&rsvp.ID,
+			&rsvp.EventID,
+			&rsvp.UserProfileID,
+			&rsvp.OccurrenceStart,
+			&rsvp.Status,
+			&rsvp.WaitlistPosition,
+			&rsvp.Ticket,
+			&rsvp.CheckedInAt,
+			&rsvp.CheckedInBy,
+			&rsvp.Version,
+			&rsvp.CreatedAt,
+			&rsvp.UpdatedAt,
+			&rsvp.DeletedAt,
+		}
+		if err := rows.Scan(append(dest, eventScanDest(rsvp.Event)...)...); err != nil {
+			return nil, err
+		}
+
+		rsvps = append(rsvps, &rsvp)
+	}
+
+	return rsvps, nil
+}
+
+func createEventRSVPValues(rsvp *models.EventRSVP) []any {
+	return []any{
+		rsvp.EventID,
+		rsvp.UserProfileID,
+		rsvp.OccurrenceStart,
+		rsvp.Status,
+		rsvp.WaitlistPosition,
+		rsvp.Ticket,
+	}
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanEventRSVP(row rowScanner) (*models.EventRSVP, error) {
+	var rsvp models.EventRSVP
+	err := row.Scan(
+		&rsvp.ID,
+		&rsvp.EventID,
+		&rsvp.UserProfileID,
+		&rsvp.OccurrenceStart,
+		&rsvp.Status,
+		&rsvp.WaitlistPosition,
+		&rsvp.Ticket,
+		&rsvp.CheckedInAt,
+		&rsvp.CheckedInBy,
+		&rsvp.Version,
+		&rsvp.CreatedAt,
+		&rsvp.UpdatedAt,
+		&rsvp.DeletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rsvp, nil
+}
+
+// eventOccurrence identifies the occurrence an RSVP is for. The start is
+// nil for events that do not recur.
+type eventOccurrence struct {
+	eventID int64
+	start   *string
+}
+
+// lockEventTx locks the event's row until the transaction ends and returns
+// the capacity of the occurrence.
+func lockEventTx(ctx context.Context, tx *sql.Tx, occurrence eventOccurrence) (int, error) {
+	query := `
+		SELECT COALESCE(o.capacity, e.capacity) FROM events e
+		LEFT JOIN event_occurrence_overrides o
+			ON o.event_id = e.id AND o.occurrence_start = $2::TIMESTAMPTZ AND o.deleted_at IS NULL
+		WHERE e.id = $1 AND e.deleted_at IS NULL
+		FOR UPDATE OF e
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var capacity int
+	if err := tx.QueryRowContext(ctx, query, occurrence.eventID, occurrence.start).Scan(&capacity); err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return 0, ErrNotFound
+		default:
+			return 0, err
+		}
+	}
+
+	return capacity, nil
+}
+
+func getEventRSVPForUpdateTx(ctx context.Context, tx *sql.Tx, occurrence eventOccurrence, userID int64) (*models.EventRSVP, error) {
+	query := fmt.Sprintf(`
+		SELECT %s FROM event_rsvps
+		WHERE event_id = $1 AND occurrence_start IS NOT DISTINCT FROM $2::TIMESTAMPTZ
+			AND user_id = $3 AND deleted_at IS NULL
+		FOR UPDATE
+	`, eventRSVPColumns)
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rsvp, err := scanEventRSVP(tx.QueryRowContext(ctx, query, occurrence.eventID, occurrence.start, userID))
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return nil, ErrNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return rsvp, nil
+}
+
+func countGoingTx(ctx context.Context, tx *sql.Tx, occurrence eventOccurrence) (int, error) {
+	query := `
+		SELECT COUNT(*) FROM event_rsvps
+		WHERE event_id = $1 AND occurrence_start IS NOT DISTINCT FROM $2::TIMESTAMPTZ
+			AND status = $3 AND deleted_at IS NULL
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var count int
+	err := tx.QueryRowContext(ctx, query, occurrence.eventID, occurrence.start, models.RSVPGoing).Scan(&count)
+	return count, err
+}
+
+func nextWaitlistPositionTx(ctx context.Context, tx *sql.Tx, occurrence eventOccurrence) (int, error) {
+	query := `
+		SELECT COALESCE(MAX(waitlist_position), 0) + 1 FROM event_rsvps
+		WHERE event_id = $1 AND occurrence_start IS NOT DISTINCT FROM $2::TIMESTAMPTZ
+			AND status = $3 AND deleted_at IS NULL
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var position int
+	err := tx.QueryRowContext(ctx, query, occurrence.eventID, occurrence.start, models.RSVPWaitlisted).Scan(&position)
+	return position, err
+}
+
+// updateEventRSVPTx saves the user's new response. RSVPs keep the ticket
+// they were first issued.
+func updateEventRSVPTx(ctx context.Context, tx *sql.Tx, rsvp *models.EventRSVP) error {
+	query := `
+		UPDATE event_rsvps
+		SET status = $1, waitlist_position = $2, ticket = COALESCE(ticket, $3),
+			version = version + 1
+		WHERE id = $4
+		RETURNING ticket, checked_in_at, checked_in_by, version, updated_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	values := []any{rsvp.Status, rsvp.WaitlistPosition, rsvp.Ticket, rsvp.ID}
+	return tx.QueryRowContext(ctx, query, values...).Scan(
+		&rsvp.Ticket,
+		&rsvp.CheckedInAt,
+		&rsvp.CheckedInBy,
+		&rsvp.Version,
+		&rsvp.UpdatedAt,
+	)
+}
+
+// promoteWaitlistTx gives the first user on the occurrence's waitlist a
+// spot and sends them the notification. It does nothing if the waitlist
+// is empty.
+func promoteWaitlistTx(ctx context.Context, tx *sql.Tx, occurrence eventOccurrence, notification *models.Notification) error {
+	query := `
+		UPDATE event_rsvps
+		SET status = $1, waitlist_position = NULL, version = version + 1
+		WHERE id = (
+			SELECT id FROM event_rsvps
+			WHERE event_id = $2 AND occurrence_start IS NOT DISTINCT FROM $3::TIMESTAMPTZ
+				AND status = $4 AND deleted_at IS NULL
+			ORDER BY waitlist_position ASC
+			LIMIT 1
+		)
+		RETURNING user_id
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var userID int64
+	values := []any{models.RSVPGoing, occurrence.eventID, occurrence.start, models.RSVPWaitlisted}
+	err := tx.QueryRowContext(ctx, query, values...).Scan(&userID)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return nil
+		default:
+			return err
+		}
+	}
+
+	if err := shiftWaitlistTx(ctx, tx, occurrence, 1); err != nil {
+		return err
+	}
+
+	notification.UserProfileID = userID
+	notification.EventID = &occurrence.eventID
+	return createNotificationTx(ctx, tx, notification)
+}
+
+// shiftWaitlistTx moves everyone behind the given position on the
+// occurrence's waitlist one spot forward.
+func shiftWaitlistTx(ctx context.Context, tx *sql.Tx, occurrence eventOccurrence, position int) error {
+	query := `
+		UPDATE event_rsvps
+		SET waitlist_position = waitlist_position - 1
+		WHERE event_id = $1 AND occurrence_start IS NOT DISTINCT FROM $2::TIMESTAMPTZ
+			AND status = $3 AND waitlist_position > $4 AND deleted_at IS NULL
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	_, err := tx.ExecContext(ctx, query, occurrence.eventID, occurrence.start, models.RSVPWaitlisted, position)
+	return err
+}
@@ -0,0 +1,758 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/rrule"
+	"github.com/lib/pq"
+)
+
+const eventColumns = `
+	id, org_id, title, description, start_time, end_time, location,
+	online_url, venue_id, capacity, published_at, published_by, cancelled_at,
+	cancellation_reason, rrule, exdates, topics, version, created_at,
+	updated_at, deleted_at
+`
+
+const createEventQuery = `
+	INSERT INTO events(
+		org_id, title, description, start_time, end_time, location, online_url,
+		venue_id, capacity, published_at, published_by, rrule, exdates, topics,
+		series_end_time
+	)
+	VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+	RETURNING id, start_time, end_time, version, created_at, updated_at, deleted_at
+`
+
+const (
+	// earthRadiusKm is the mean radius of the Earth used to work out the
+	// distance between two points.
+	earthRadiusKm = 6371.0
+	// kmPerDegree is the length of a degree of latitude in kilometres.
+	kmPerDegree = 111.045
+	// nearbyEventsLimit is the most events GetNearby returns.
+	nearbyEventsLimit = 100
+)
+
+// EventCursor is the position of an event in a list of events sorted by
+// start time and then ID.
+type EventCursor struct {
+	StartTime string `json:"startTime"`
+	ID        int64  `json:"id"`
+}
+
+// EventFilter narrows down the events returned by GetPublished. Events
+// that start between From and To, and recurring events whose series starts
+// before To and has occurrences after From, are returned. The rest of the
+// fields are ignored when they are empty.
+type EventFilter struct {
+	From           string
+	To             string
+	Topic          string
+	OrganizationID int64
+	// Online returns events with an online URL when true and events with a
+	// location or venue when false. Hybrid events match both.
+	Online *bool
+	// Query matches events whose title or description match it, using the
+	// web search syntax of Postgres.
+	Query string
+	// After returns the events that come after the cursor.
+	After *EventCursor
+	Limit int
+}
+
+type EventStore struct {
+	db *sql.DB
+}
+
+func (s *EventStore) Create(ctx context.Context, event *models.Event) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	return s.db.QueryRowContext(ctx, createEventQuery, createEventValues(event)...).Scan(
+		&event.ID,
+		&event.StartTime,
+		&event.EndTime,
+		&event.Version,
+		&event.CreatedAt,
+		&event.UpdatedAt,
+		&event.DeletedAt,
+	)
+}
+
+func (s *EventStore) Get(ctx context.Context, isDeleted bool, fields []string, values []any) (*models.Event, error) {
+	query := fmt.Sprintf("SELECT %s FROM events WHERE %s", eventColumns, generateQueryConditions(isDeleted, fields))
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var event models.Event
+	err := s.db.QueryRowContext(ctx, query, values...).Scan(eventScanDest(&event)...)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return nil, ErrNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return &event, nil
+}
+
+func (s *EventStore) GetByOrgID(ctx context.Context, orgID int64, includeDrafts bool) ([]*models.SimpleEvent, error) {
+	query := `
+		SELECT id, title, description, start_time, end_time, location, online_url,
+			venue_id, capacity, published_at, cancelled_at, cancellation_reason, rrule, exdates,
+			topics
+		FROM events
+		WHERE org_id = $1 AND deleted_at IS NULL AND ($2 OR published_at IS NOT NULL)
+		ORDER BY start_time ASC, id ASC
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, query, orgID, includeDrafts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*models.SimpleEvent
+	for rows.Next() {
+		var event models.SimpleEvent
+		err := rows.Scan(
+			&event.ID,
+			&event.Title,
+			&event.Description,
+			&event.StartTime,
+			&event.EndTime,
+			&event.Location,
+			&event.OnlineURL,
+			&event.VenueID,
+			&event.Capacity,
+			&event.PublishedAt,
+			&event.CancelledAt,
+			&event.CancellationReason,
+			&event.RRule,
+			pq.Array(&event.ExDates),
+			pq.Array(&event.Topics),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, &event)
+	}
+
+	return events, nil
+}
+
+// GetPublishedByOrgID returns the organization's published events, including
+// cancelled ones, so that they can be shared outside the application.
+func (s *EventStore) GetPublishedByOrgID(ctx context.Context, orgID int64) ([]*models.Event, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM events
+		WHERE org_id = $1 AND published_at IS NOT NULL AND deleted_at IS NULL
+		ORDER BY start_time ASC, id ASC
+	`, eventColumns)
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, query, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*models.Event
+	for rows.Next() {
+		var event models.Event
+		if err := rows.Scan(eventScanDest(&event)...); err != nil {
+			return nil, err
+		}
+
+		events = append(events, &event)
+	}
+
+	return events, nil
+}
+
+// GetPublished returns the published events of active organizations that
+// match the filter, sorted by start time and then ID so that they can be
+// paged through with a cursor. Recurring events are placed by the start of
+// their series and are returned while the series has occurrences after
+// the filter's From.
+func (s *EventStore) GetPublished(ctx context.Context, filter EventFilter) ([]*models.FeedEvent, error) {
+	conditions := []string{
+		"e.published_at IS NOT NULL",
+		"e.cancelled_at IS NULL",
+		"e.deleted_at IS NULL",
+		"o.is_active",
+		"o.deleted_at IS NULL",
+	}
+	var values []any
+	addCondition := func(condition string, value any) {
+		values = append(values, value)
+		conditions = append(conditions, fmt.Sprintf(condition, len(values)))
+	}
+
+	// A recurring event is listed until the last occurrence of its series
+	// has started, not just when the series itself starts after From.
+	addCondition("(e.start_time >= $%[1]d OR (e.rrule <> '' AND (e.series_end_time IS NULL OR e.series_end_time >= $%[1]d)))", filter.From)
+	addCondition("e.start_time <= $%d", filter.To)
+	if filter.Topic != "" {
+		addCondition("$%d = ANY(e.topics)", filter.Topic)
+	}
+	if filter.OrganizationID != 0 {
+		addCondition("e.org_id = $%d", filter.OrganizationID)
+	}
+	if filter.Online != nil {
+		if *filter.Online {
+			conditions = append(conditions, "e.online_url <> ''")
+		} else {
+			conditions = append(conditions, "(e.location <> '' OR e.venue_id IS NOT NULL)")
+		}
+	}
+	if filter.Query != "" {
+		addCondition("e.search_vector @@ websearch_to_tsquery('english', $%d)", filter.Query)
+	}
+	if filter.After != nil {
+		values = append(values, filter.After.StartTime, filter.After.ID)
+		conditions = append(conditions, fmt.Sprintf("(e.start_time, e.id) > ($%d::TIMESTAMPTZ, $%d)", len(values)-1, len(values)))
+	}
+	values = append(values, filter.Limit)
+
+	query := fmt.Sprintf(`
+		SELECT e.id, e.title, e.description, e.start_time, e.end_time, e.location,
+			e.online_url, e.venue_id, e.capacity, e.published_at, e.cancelled_at,
+			e.cancellation_reason, e.rrule, e.exdates, e.topics, o.id, o.name,
+			o.description, o.profile_pic
+		FROM events e
+		JOIN organizations o ON o.id = e.org_id
+		WHERE %s
+		ORDER BY e.start_time ASC, e.id ASC
+		LIMIT $%d
+	`, strings.Join(conditions, " AND "), len(values))
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, query, values...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*models.FeedEvent
+	for rows.Next() {
+		var event models.FeedEvent
+		err := rows.Scan(
+			&event.ID,
+			&event.Title,
+			&event.Description,
+			&event.StartTime,
+			&event.EndTime,
+			&event.Location,
+			&event.OnlineURL,
+			&event.VenueID,
+			&event.Capacity,
+			&event.PublishedAt,
+			&event.CancelledAt,
+			&event.CancellationReason,
+			&event.RRule,
+			pq.Array(&event.ExDates),
+			pq.Array(&event.Topics),
+			&event.Organization.ID,
+			&event.Organization.Name,
+			&event.Organization.Description,
+			&event.Organization.ProfilePic,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, &event)
+	}
+
+	return events, nil
+}
+
+// GetNearby returns the published events that are not cancelled, at venues
+// within radiusKm of the location, closest first. Events that have not
+// started yet and recurring events whose series has not ended are returned,
+// it is up to the caller to check whether a recurring event still has
+// upcoming occurrences.
+//
+// The distance is worked out with the haversine formula. Venues outside a
+// bounding box around the location are ruled out first so that the index on
+// the venues' coordinates can be used. The longitude range is not checked
+// when the box goes over a pole or the antimeridian.
+func (s *EventStore) GetNearby(ctx context.Context, latitude, longitude, radiusKm float64) ([]*models.NearbyEvent, error) {
+	query := `
+		SELECT id, org_id, title, description, start_time, end_time, location,
+			online_url, venue_id, capacity, published_at, cancelled_at,
+			cancellation_reason, rrule, exdates, topics, venue_name, venue_address,
+			venue_latitude, venue_longitude, venue_capacity,
+			venue_accessibility_notes, distance_km
+		FROM (
+			SELECT e.id, e.org_id, e.title, e.description, e.start_time, e.end_time,
+				e.location, e.online_url, e.venue_id, e.capacity, e.published_at,
+				e.cancelled_at, e.cancellation_reason, e.rrule, e.exdates, e.topics,
+				v.name AS venue_name, v.address AS venue_address,
+				v.latitude AS venue_latitude, v.longitude AS venue_longitude,
+				v.capacity AS venue_capacity,
+				v.accessibility_notes AS venue_accessibility_notes,
+				2 * $3::DOUBLE PRECISION * ASIN(LEAST(1, SQRT(
+					POWER(SIN(RADIANS(v.latitude - $1) / 2), 2) +
+					COS(RADIANS($1)) * COS(RADIANS(v.latitude)) *
+					POWER(SIN(RADIANS(v.longitude - $2) / 2), 2)
+				))) AS distance_km
+			FROM events e
+			JOIN venues v ON v.id = e.venue_id AND v.deleted_at IS NULL
+			JOIN organizations o ON o.id = e.org_id AND o.is_active AND o.deleted_at IS NULL
+			WHERE e.published_at IS NOT NULL AND e.cancelled_at IS NULL
+				AND e.deleted_at IS NULL
+				AND (e.start_time > NOW() OR (e.rrule <> '' AND (e.series_end_time IS NULL OR e.series_end_time > NOW())))
+				AND v.latitude BETWEEN $4 AND $5
+				AND ($6::DOUBLE PRECISION IS NULL OR v.longitude BETWEEN $6 AND $7)
+		) nearby
+		WHERE distance_km <= $8
+		ORDER BY distance_km ASC, start_time ASC, id ASC
+		LIMIT $9
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	latitudeDelta := radiusKm / kmPerDegree
+	minLatitude, maxLatitude := latitude-latitudeDelta, latitude+latitudeDelta
+
+	var minLongitude, maxLongitude *float64
+	if minLatitude > -90 && maxLatitude < 90 {
+		longitudeDelta := latitudeDelta / math.Cos(latitude*math.Pi/180)
+		if longitude-longitudeDelta > -180 && longitude+longitudeDelta < 180 {
+			minValue, maxValue := longitude-longitudeDelta, longitude+longitudeDelta
+			minLongitude, maxLongitude = &minValue, &maxValue
+		}
+	}
+
+	values := []any{
+		latitude,
+		longitude,
+		earthRadiusKm,
+		minLatitude,
+		maxLatitude,
+		minLongitude,
+		maxLongitude,
+		radiusKm,
+		nearbyEventsLimit,
+	}
+	rows, err := s.db.QueryContext(ctx, query, values...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*models.NearbyEvent
+	for rows.Next() {
+		var event models.NearbyEvent
+		err := rows.Scan(
+			&event.ID,
+			&event.OrganizationID,
+			&event.Title,
+			&event.Description,
+			&event.StartTime,
+			&event.EndTime,
+			&event.Location,
+			&event.OnlineURL,
+			&event.VenueID,
+			&event.Capacity,
+			&event.PublishedAt,
+			&event.CancelledAt,
+			&event.CancellationReason,
+			&event.RRule,
+			pq.Array(&event.ExDates),
+			pq.Array(&event.Topics),
+			&event.Venue.Name,
+			&event.Venue.Address,
+			&event.Venue.Latitude,
+			&event.Venue.Longitude,
+			&event.Venue.Capacity,
+			&event.Venue.AccessibilityNotes,
+			&event.DistanceKm,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		event.Venue.ID = *event.VenueID
+		events = append(events, &event)
+	}
+
+	return events, nil
+}
+
+func (s *EventStore) Update(ctx context.Context, event *models.Event) error {
+	query := `
+		UPDATE events
+		SET title = $1, description = $2, start_time = $3, end_time = $4,
+			location = $5, online_url = $6, venue_id = $7, capacity = $8,
+			rrule = $9, exdates = $10, topics = $11, series_end_time = $12,
+			version = version + 1
+		WHERE id = $13 AND version = $14
+		RETURNING start_time, end_time, version, updated_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	err := s.db.QueryRowContext(
+		ctx,
+		query,
+		event.Title,
+		event.Description,
+		event.StartTime,
+		event.EndTime,
+		event.Location,
+		event.OnlineURL,
+		event.VenueID,
+		event.Capacity,
+		event.RRule,
+		exDatesValue(event.ExDates),
+		textArrayValue(event.Topics),
+		seriesEndValue(event),
+		event.ID,
+		event.Version,
+	).Scan(
+		&event.StartTime,
+		&event.EndTime,
+		&event.Version,
+		&event.UpdatedAt,
+	)
+
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// HasRSVPsOrOverrides reports whether the event has RSVPs or occurrence
+// overrides. Both are tied to the start times of the event's occurrences.
+func (s *EventStore) HasRSVPsOrOverrides(ctx context.Context, eventID int64) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM event_rsvps WHERE event_id = $1 AND deleted_at IS NULL
+		) OR EXISTS (
+			SELECT 1 FROM event_occurrence_overrides WHERE event_id = $1 AND deleted_at IS NULL
+		)
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var exists bool
+	if err := s.db.QueryRowContext(ctx, query, eventID).Scan(&exists); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (s *EventStore) Publish(ctx context.Context, event *models.Event, userProfileID int64) error {
+	query := `
+		UPDATE events
+		SET published_at = $1, published_by = $2, version = version + 1
+		WHERE id = $3 AND version = $4
+		RETURNING published_at, published_by, version, updated_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	values := []any{time.Now().UTC().Format(internal.DateTimeFormat), userProfileID, event.ID, event.Version}
+	err := s.db.QueryRowContext(ctx, query, values...).Scan(
+		&event.PublishedAt,
+		&event.PublishedBy,
+		&event.Version,
+		&event.UpdatedAt,
+	)
+
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Cancel marks the event as cancelled and, within the same transaction,
+// sends the notification to every user who RSVP'd to the event.
+func (s *EventStore) Cancel(ctx context.Context, event *models.Event, notification *models.Notification) error {
+	return WithTx(s.db, ctx, func(tx *sql.Tx) error {
+		if err := cancelEventTx(ctx, tx, event); err != nil {
+			return err
+		}
+
+		notification.EventID = &event.ID
+		statuses := []models.RSVPStatus{models.RSVPGoing, models.RSVPMaybe, models.RSVPWaitlisted}
+		if err := createEventNotificationsTx(ctx, tx, notification, statuses); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// SplitSeries ends a recurring event's series before occurrenceStart and
+// continues it as the new series, within a single transaction. Overrides
+// and RSVPs of the occurrences from occurrenceStart onwards are moved to the
+// new series and shifted by the difference between the new series' start
+// time and occurrenceStart.
+func (s *EventStore) SplitSeries(ctx context.Context, event, newEvent *models.Event, occurrenceStart string) error {
+	return WithTx(s.db, ctx, func(tx *sql.Tx) error {
+		if err := updateEventRRuleTx(ctx, tx, event); err != nil {
+			return err
+		}
+
+		if err := createEventTx(ctx, tx, newEvent); err != nil {
+			return err
+		}
+
+		if err := moveEventOccurrencesTx(ctx, tx, event.ID, newEvent.ID, occurrenceStart, newEvent.StartTime); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (s *EventStore) SoftDelete(ctx context.Context, event *models.Event) error {
+	query := `
+		UPDATE events
+		SET deleted_at = $1, version = version + 1
+		WHERE id = $2 AND version = $3
+		RETURNING version, updated_at, deleted_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	values := []any{time.Now().UTC().Format(internal.DateTimeFormat), event.ID, event.Version}
+	err := s.db.QueryRowContext(ctx, query, values...).Scan(
+		&event.Version,
+		&event.UpdatedAt,
+		&event.DeletedAt,
+	)
+
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+func cancelEventTx(ctx context.Context, tx *sql.Tx, event *models.Event) error {
+	query := `
+		UPDATE events
+		SET cancelled_at = $1, cancellation_reason = $2, version = version + 1
+		WHERE id = $3 AND version = $4
+		RETURNING cancelled_at, version, updated_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	values := []any{time.Now().UTC().Format(internal.DateTimeFormat), event.CancellationReason, event.ID, event.Version}
+	err := tx.QueryRowContext(ctx, query, values...).Scan(
+		&event.CancelledAt,
+		&event.Version,
+		&event.UpdatedAt,
+	)
+
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// eventScanDest returns the destinations a row selected with eventColumns
+// is scanned into.
+func eventScanDest(event *models.Event) []any {
+	return []any{
+		&event.ID,
+		&event.OrganizationID,
+		&event.Title,
+		&event.Description,
+		&event.StartTime,
+		&event.EndTime,
+		&event.Location,
+		&event.OnlineURL,
+		&event.VenueID,
+		&event.Capacity,
+		&event.PublishedAt,
+		&event.PublishedBy,
+		&event.CancelledAt,
+		&event.CancellationReason,
+		&event.RRule,
+		pq.Array(&event.ExDates),
+		pq.Array(&event.Topics),
+		&event.Version,
+		&event.CreatedAt,
+		&event.UpdatedAt,
+		&event.DeletedAt,
+	}
+}
+
+// seriesEndValue returns the start time of the last occurrence of a
+// recurring event, which is saved so GetPublished can tell when its series
+// is over. It returns nil for single events and series that do not end.
+func seriesEndValue(event *models.Event) any {
+	if event.RRule == "" {
+		return nil
+	}
+
+	rule, err := rrule.Parse(event.RRule)
+	if err != nil {
+		return nil
+	}
+
+	dtstart, err := time.Parse(internal.DateTimeFormat, event.StartTime)
+	if err != nil {
+		return nil
+	}
+
+	last, ok := rule.Last(dtstart)
+	if !ok {
+		return nil
+	}
+
+	return last.UTC().Format(internal.DateTimeFormat)
+}
+
+// exDatesValue converts the exception dates into a value that can be saved
+// in the NOT NULL exdates column.
+func exDatesValue(exDates []string) any {
+	return textArrayValue(exDates)
+}
+
+// textArrayValue converts values into a value that can be saved in a NOT
+// NULL array column.
+func textArrayValue(values []string) any {
+	if values == nil {
+		values = []string{}
+	}
+
+	return pq.Array(values)
+}
+
+func createEventValues(event *models.Event) []any {
+	return []any{
+		event.OrganizationID,
+		event.Title,
+		event.Description,
+		event.StartTime,
+		event.EndTime,
+		event.Location,
+		event.OnlineURL,
+		event.VenueID,
+		event.Capacity,
+		event.PublishedAt,
+		event.PublishedBy,
+		event.RRule,
+		exDatesValue(event.ExDates),
+		textArrayValue(event.Topics),
+		seriesEndValue(event),
+	}
+}
+
+func createEventTx(ctx context.Context, tx *sql.Tx, event *models.Event) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	return tx.QueryRowContext(ctx, createEventQuery, createEventValues(event)...).Scan(
+		&event.ID,
+		&event.StartTime,
+		&event.EndTime,
+		&event.Version,
+		&event.CreatedAt,
+		&event.UpdatedAt,
+		&event.DeletedAt,
+	)
+}
+
+func updateEventRRuleTx(ctx context.Context, tx *sql.Tx, event *models.Event) error {
+	query := `
+		UPDATE events
+		SET rrule = $1, series_end_time = $2, version = version + 1
+		WHERE id = $3 AND version = $4
+		RETURNING version, updated_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	values := []any{event.RRule, seriesEndValue(event), event.ID, event.Version}
+	err := tx.QueryRowContext(ctx, query, values...).Scan(&event.Version, &event.UpdatedAt)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// moveEventOccurrencesTx moves the overrides and RSVPs of the occurrences
+// starting from occurrenceStart to the new event, shifting their occurrence
+// start times so that occurrenceStart lines up with newStart.
+func moveEventOccurrencesTx(ctx context.Context, tx *sql.Tx, eventID, newEventID int64, occurrenceStart, newStart string) error {
+	queries := []string{
+		`
+			UPDATE event_occurrence_overrides
+			SET event_id = $1, occurrence_start = occurrence_start + ($4::TIMESTAMPTZ - $3::TIMESTAMPTZ),
+				version = version + 1
+			WHERE event_id = $2 AND occurrence_start >= $3::TIMESTAMPTZ AND deleted_at IS NULL
+		`,
+		`
+			UPDATE event_rsvps
+			SET event_id = $1, occurrence_start = occurrence_start + ($4::TIMESTAMPTZ - $3::TIMESTAMPTZ),
+				version = version + 1
+			WHERE event_id = $2 AND occurrence_start >= $3::TIMESTAMPTZ AND deleted_at IS NULL
+		`,
+	}
+
+	for _, query := range queries {
+		ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+		_, err := tx.ExecContext(ctx, query, newEventID, eventID, occurrenceStart, newStart)
+		cancel()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
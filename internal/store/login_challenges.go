@@ -0,0 +1,120 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/KengoWada/meetup-clone/internal/models"
+)
+
+type LoginChallengeStore struct {
+	db *sql.DB
+}
+
+func (s *LoginChallengeStore) Create(ctx context.Context, challenge *models.LoginChallenge) error {
+	query := `
+		INSERT INTO login_challenges(user_id, token_hash, device, expires_at)
+		VALUES($1, $2, $3, $4)
+		RETURNING id, attempts, expires_at, created_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	return s.db.QueryRowContext(
+		ctx,
+		query,
+		challenge.UserID,
+		challenge.TokenHash,
+		challenge.Device,
+		challenge.ExpiresAt,
+	).Scan(
+		&challenge.ID,
+		&challenge.Attempts,
+		&challenge.ExpiresAt,
+		&challenge.CreatedAt,
+	)
+}
+
+// GetByTokenHash returns the challenge with the hash, whether or not it has
+// been used or has expired.
+func (s *LoginChallengeStore) GetByTokenHash(ctx context.Context, tokenHash string) (*models.LoginChallenge, error) {
+	query := `
+		SELECT id, user_id, token_hash, device, attempts, expires_at, used_at, created_at
+		FROM login_challenges
+		WHERE token_hash = $1
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var challenge models.LoginChallenge
+	err := s.db.QueryRowContext(ctx, query, tokenHash).Scan(
+		&challenge.ID,
+		&challenge.UserID,
+		&challenge.TokenHash,
+		&challenge.Device,
+		&challenge.Attempts,
+		&challenge.ExpiresAt,
+		&challenge.UsedAt,
+		&challenge.CreatedAt,
+	)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return nil, ErrNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return &challenge, nil
+}
+
+// RecordAttempt counts an attempt to finish logging in with the challenge.
+// It returns ErrNotFound if the challenge has already been used.
+func (s *LoginChallengeStore) RecordAttempt(ctx context.Context, challenge *models.LoginChallenge) error {
+	query := `
+		UPDATE login_challenges
+		SET attempts = attempts + 1
+		WHERE id = $1 AND used_at IS NULL
+		RETURNING attempts
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	err := s.db.QueryRowContext(ctx, query, challenge.ID).Scan(&challenge.Attempts)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Use marks the challenge as used so it can't log the user in again. It
+// returns ErrNotFound if the challenge has already been used.
+func (s *LoginChallengeStore) Use(ctx context.Context, challenge *models.LoginChallenge) error {
+	query := `
+		UPDATE login_challenges
+		SET used_at = NOW()
+		WHERE id = $1 AND used_at IS NULL
+		RETURNING used_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	err := s.db.QueryRowContext(ctx, query, challenge.ID).Scan(&challenge.UsedAt)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
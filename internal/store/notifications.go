@@ -0,0 +1,94 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/lib/pq"
+)
+
+type NotificationStore struct {
+	db *sql.DB
+}
+
+func (s *NotificationStore) GetByUserID(ctx context.Context, userID int64) ([]*models.Notification, error) {
+	query := `
+		SELECT id, user_id, event_id, type, message, read_at, version, created_at, updated_at, deleted_at
+		FROM notifications
+		WHERE user_id = $1 AND deleted_at IS NULL
+		ORDER BY created_at DESC, id DESC
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notifications []*models.Notification
+	for rows.Next() {
+		var notification models.Notification
+		err := rows.Scan(
+			&notification.ID,
+			&notification.UserProfileID,
+			&notification.EventID,
+			&notification.Type,
+			&notification.Message,
+			&notification.ReadAt,
+			&notification.Version,
+			&notification.CreatedAt,
+			&notification.UpdatedAt,
+			&notification.DeletedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		notifications = append(notifications, &notification)
+	}
+
+	return notifications, nil
+}
+
+func createNotificationTx(ctx context.Context, tx *sql.Tx, notification *models.Notification) error {
+	query := `
+		INSERT INTO notifications(user_id, event_id, type, message)
+		VALUES($1, $2, $3, $4)
+		RETURNING id, version, created_at, updated_at, deleted_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	values := []any{notification.UserProfileID, notification.EventID, notification.Type, notification.Message}
+	return tx.QueryRowContext(ctx, query, values...).Scan(
+		&notification.ID,
+		&notification.Version,
+		&notification.CreatedAt,
+		&notification.UpdatedAt,
+		&notification.DeletedAt,
+	)
+}
+
+// createEventNotificationsTx sends a copy of the notification to every user
+// whose RSVP to the notification's event has one of the given statuses.
+func createEventNotificationsTx(ctx context.Context, tx *sql.Tx, notification *models.Notification, statuses []models.RSVPStatus) error {
+	query := `
+		INSERT INTO notifications(user_id, event_id, type, message)
+		SELECT user_id, event_id, $1, $2 FROM event_rsvps
+		WHERE event_id = $3 AND status = ANY($4) AND deleted_at IS NULL
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rsvpStatuses := make([]string, len(statuses))
+	for index, status := range statuses {
+		rsvpStatuses[index] = string(status)
+	}
+
+	values := []any{notification.Type, notification.Message, notification.EventID, pq.Array(rsvpStatuses)}
+	_, err := tx.ExecContext(ctx, query, values...)
+	return err
+}
@@ -6,17 +6,23 @@ import (
 	"fmt"
 
 	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/lib/pq"
 )
 
+const orgInviteColumns = `
+	id, org_id, user_id, role_id, accepted_at, declined_at, version, created_at,
+	updated_at, deleted_at, expires_at, email
+`
+
 type OrganizationInviteStore struct {
 	db *sql.DB
 }
 
 func (s *OrganizationInviteStore) Create(ctx context.Context, invite *models.OrganizationInvite) error {
 	query := `
-		INSERT INTO organization_invites(org_id, user_id, role_id)
-		VALUES($1, $2, $3)
-		RETURNING id, created_at
+		INSERT INTO organization_invites(org_id, user_id, email, role_id, expires_at)
+		VALUES($1, $2, $3, $4, $5)
+		RETURNING id, version, created_at, expires_at
 	`
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
 	defer cancel()
@@ -26,10 +32,14 @@ func (s *OrganizationInviteStore) Create(ctx context.Context, invite *models.Org
 		query,
 		invite.OrganizationID,
 		invite.UserProfileID,
+		invite.Email,
 		invite.RoleID,
+		invite.ExpiresAt,
 	).Scan(
 		&invite.ID,
+		&invite.Version,
 		&invite.CreatedAt,
+		&invite.ExpiresAt,
 	)
 
 	if err != nil {
@@ -44,8 +54,14 @@ func (s *OrganizationInviteStore) Create(ctx context.Context, invite *models.Org
 	return nil
 }
 
+// Get returns the invite matching the fields. When a user has been invited
+// to an organization more than once the newest invite is returned.
 func (s *OrganizationInviteStore) Get(ctx context.Context, isDeleted bool, fields []string, values []any) (*models.OrganizationInvite, error) {
-	query := fmt.Sprintf("SELECT * FROM organization_invites WHERE %s ORDER BY created_at ASC", generateQueryConditions(isDeleted, fields))
+	query := fmt.Sprintf(
+		"SELECT %s FROM organization_invites WHERE %s ORDER BY created_at DESC, id DESC",
+		orgInviteColumns,
+		generateQueryConditions(isDeleted, fields),
+	)
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
 	defer cancel()
 
@@ -61,6 +77,8 @@ func (s *OrganizationInviteStore) Get(ctx context.Context, isDeleted bool, field
 		&invite.CreatedAt,
 		&invite.UpdatedAt,
 		&invite.DeletedAt,
+		&invite.ExpiresAt,
+		&invite.Email,
 	)
 
 	if err != nil {
@@ -74,3 +92,266 @@ func (s *OrganizationInviteStore) Get(ctx context.Context, isDeleted bool, field
 
 	return &invite, nil
 }
+
+// GetPendingByUserID returns the invites the user has not yet responded to,
+// newest first. Expired invites, invites to inactive or deleted
+// organizations and invites with a deleted role are left out.
+func (s *OrganizationInviteStore) GetPendingByUserID(ctx context.Context, userID int64) ([]*models.PendingInvite, error) {
+	query := `
+		SELECT i.id, o.id, o.name, o.description, o.profile_pic,
+			r.id, r.name, r.description, r.permissions, i.expires_at, i.created_at
+		FROM organization_invites i
+		INNER JOIN organizations o
+			ON o.id = i.org_id AND o.is_active = TRUE AND o.deleted_at IS NULL
+		INNER JOIN roles r
+			ON r.id = i.role_id AND r.deleted_at IS NULL
+		WHERE i.user_id = $1 AND i.accepted_at IS NULL AND i.declined_at IS NULL
+			AND i.expires_at > NOW() AND i.deleted_at IS NULL
+		ORDER BY i.created_at DESC, i.id DESC
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var invites []*models.PendingInvite
+	for rows.Next() {
+		var invite models.PendingInvite
+		err := rows.Scan(
+			&invite.ID,
+			&invite.Organization.ID,
+			&invite.Organization.Name,
+			&invite.Organization.Description,
+			&invite.Organization.ProfilePic,
+			&invite.Role.ID,
+			&invite.Role.Name,
+			&invite.Role.Description,
+			pq.Array(&invite.Role.Permissions),
+			&invite.ExpiresAt,
+			&invite.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		invites = append(invites, &invite)
+	}
+
+	return invites, nil
+}
+
+// Accept marks the invite as accepted and adds the invited user to the
+// organization with the invite's role in a single transaction. It returns
+// ErrNotFound if the invite has changed since it was fetched, and
+// ErrDuplicateMember if the user is already a member.
+func (s *OrganizationInviteStore) Accept(ctx context.Context, invite *models.OrganizationInvite, member *models.OrganizationMember) error {
+	return WithTx(s.db, ctx, func(tx *sql.Tx) error {
+		if err := acceptOrgInviteTx(ctx, tx, invite); err != nil {
+			return err
+		}
+
+		member.OrganizationID = invite.OrganizationID
+		member.UserProfileID = *invite.UserProfileID
+		member.RoleID = invite.RoleID
+		return createOrgMemberTx(ctx, tx, member)
+	})
+}
+
+// Decline marks the invite as declined. It returns ErrNotFound if the
+// invite has changed since it was fetched.
+func (s *OrganizationInviteStore) Decline(ctx context.Context, invite *models.OrganizationInvite) error {
+	query := `
+		UPDATE organization_invites
+		SET declined_at = NOW(), version = version + 1
+		WHERE id = $1 AND version = $2 AND accepted_at IS NULL AND declined_at IS NULL
+			AND deleted_at IS NULL
+		RETURNING declined_at, version, updated_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	err := s.db.QueryRowContext(ctx, query, invite.ID, invite.Version).Scan(
+		&invite.DeclinedAt,
+		&invite.Version,
+		&invite.UpdatedAt,
+	)
+
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetPendingByOrgID returns the invites sent by the organization that have
+// not been responded to, newest first. Expired invites are included so that
+// they can be re-sent. Invites sent to an email address without an account
+// have no user profile.
+func (s *OrganizationInviteStore) GetPendingByOrgID(ctx context.Context, orgID int64) ([]*models.SentInvite, error) {
+	query := `
+		SELECT i.id, p.id, COALESCE(p.username, ''), COALESCE(p.profile_pic, ''),
+			COALESCE(i.email, ''), r.id, r.name, r.description, r.permissions,
+			i.expires_at, i.created_at
+		FROM organization_invites i
+		LEFT JOIN user_profiles p
+			ON p.id = i.user_id
+		INNER JOIN roles r
+			ON r.id = i.role_id
+		WHERE i.org_id = $1 AND i.accepted_at IS NULL AND i.declined_at IS NULL
+			AND i.deleted_at IS NULL
+		ORDER BY i.created_at DESC, i.id DESC
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, query, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var invites []*models.SentInvite
+	for rows.Next() {
+		var invite models.SentInvite
+		err := rows.Scan(
+			&invite.ID,
+			&invite.UserProfileID,
+			&invite.Username,
+			&invite.ProfilePic,
+			&invite.Email,
+			&invite.Role.ID,
+			&invite.Role.Name,
+			&invite.Role.Description,
+			pq.Array(&invite.Role.Permissions),
+			&invite.ExpiresAt,
+			&invite.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		invites = append(invites, &invite)
+	}
+
+	return invites, nil
+}
+
+// Resend gives a pending invite a new role and expiry time. It returns
+// ErrNotFound if the invite has changed since it was fetched.
+func (s *OrganizationInviteStore) Resend(ctx context.Context, invite *models.OrganizationInvite) error {
+	query := `
+		UPDATE organization_invites
+		SET role_id = $1, expires_at = $2, version = version + 1
+		WHERE id = $3 AND version = $4 AND accepted_at IS NULL AND declined_at IS NULL
+			AND deleted_at IS NULL
+		RETURNING expires_at, version, updated_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	values := []any{invite.RoleID, invite.ExpiresAt, invite.ID, invite.Version}
+	err := s.db.QueryRowContext(ctx, query, values...).Scan(
+		&invite.ExpiresAt,
+		&invite.Version,
+		&invite.UpdatedAt,
+	)
+
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Revoke soft deletes a pending invite so that it can no longer be
+// accepted. It returns ErrNotFound if the invite has changed since it was
+// fetched.
+func (s *OrganizationInviteStore) Revoke(ctx context.Context, invite *models.OrganizationInvite) error {
+	query := `
+		UPDATE organization_invites
+		SET deleted_at = NOW(), version = version + 1
+		WHERE id = $1 AND version = $2 AND accepted_at IS NULL AND declined_at IS NULL
+			AND deleted_at IS NULL
+		RETURNING version, updated_at, deleted_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	err := s.db.QueryRowContext(ctx, query, invite.ID, invite.Version).Scan(
+		&invite.Version,
+		&invite.UpdatedAt,
+		&invite.DeletedAt,
+	)
+
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// attachOrgInvitesTx hands the pending invites sent to the user's email
+// address over to their profile, so that they show up like any other
+// invite.
+func attachOrgInvitesTx(ctx context.Context, tx *sql.Tx, user *models.User) error {
+	query := `
+		UPDATE organization_invites i
+		SET user_id = p.id, email = NULL, version = i.version + 1
+		FROM user_profiles p
+		WHERE p.user_id = $1 AND i.email = $2 AND i.user_id IS NULL
+			AND i.accepted_at IS NULL AND i.declined_at IS NULL AND i.deleted_at IS NULL
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	_, err := tx.ExecContext(ctx, query, user.ID, user.Email)
+	return err
+}
+
+func acceptOrgInviteTx(ctx context.Context, tx *sql.Tx, invite *models.OrganizationInvite) error {
+	query := `
+		UPDATE organization_invites
+		SET accepted_at = NOW(), version = version + 1
+		WHERE id = $1 AND version = $2 AND accepted_at IS NULL AND declined_at IS NULL
+			AND expires_at > NOW() AND deleted_at IS NULL
+		RETURNING accepted_at, version, updated_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	err := tx.QueryRowContext(ctx, query, invite.ID, invite.Version).Scan(
+		&invite.AcceptedAt,
+		&invite.Version,
+		&invite.UpdatedAt,
+	)
+
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/KengoWada/meetup-clone/internal/models"
+)
+
+const orgJoinLinkColumns = `
+	id, org_id, role_id, token, max_uses, uses, expires_at, disabled_at,
+	created_by, version, created_at, updated_at, deleted_at
+`
+
+type OrganizationJoinLinkStore struct {
+	db *sql.DB
+}
+
+func (s *OrganizationJoinLinkStore) Create(ctx context.Context, link *models.OrganizationJoinLink) error {
+	query := `
+		INSERT INTO organization_join_links(org_id, role_id, token, max_uses, expires_at, created_by)
+		VALUES($1, $2, $3, $4, $5, $6)
+		RETURNING id, uses, expires_at, version, created_at, updated_at, deleted_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	return s.db.QueryRowContext(
+		ctx,
+		query,
+		link.OrganizationID,
+		link.RoleID,
+		link.Token,
+		link.MaxUses,
+		link.ExpiresAt,
+		link.CreatedBy,
+	).Scan(
+		&link.ID,
+		&link.Uses,
+		&link.ExpiresAt,
+		&link.Version,
+		&link.CreatedAt,
+		&link.UpdatedAt,
+		&link.DeletedAt,
+	)
+}
+
+func (s *OrganizationJoinLinkStore) Get(ctx context.Context, isDeleted bool, fields []string, values []any) (*models.OrganizationJoinLink, error) {
+	query := fmt.Sprintf("SELECT %s FROM organization_join_links WHERE %s", orgJoinLinkColumns, generateQueryConditions(isDeleted, fields))
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	link, err := scanOrgJoinLink(s.db.QueryRowContext(ctx, query, values...))
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return nil, ErrNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return link, nil
+}
+
+// GetByOrgID returns the organization's join links, newest first.
+func (s *OrganizationJoinLinkStore) GetByOrgID(ctx context.Context, orgID int64) ([]*models.OrganizationJoinLink, error) {
+	query := fmt.Sprintf(`
+		SELECT %s FROM organization_join_links
+		WHERE org_id = $1 AND deleted_at IS NULL
+		ORDER BY created_at DESC, id DESC
+	`, orgJoinLinkColumns)
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, query, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var links []*models.OrganizationJoinLink
+	for rows.Next() {
+		link, err := scanOrgJoinLink(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		links = append(links, link)
+	}
+
+	return links, nil
+}
+
+// Disable stops the link from being used to join the organization. It
+// returns ErrNotFound if the link has changed since it was fetched.
+func (s *OrganizationJoinLinkStore) Disable(ctx context.Context, link *models.OrganizationJoinLink) error {
+	query := `
+		UPDATE organization_join_links
+		SET disabled_at = NOW(), version = version + 1
+		WHERE id = $1 AND version = $2 AND disabled_at IS NULL
+		RETURNING disabled_at, version, updated_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	err := s.db.QueryRowContext(ctx, query, link.ID, link.Version).Scan(
+		&link.DisabledAt,
+		&link.Version,
+		&link.UpdatedAt,
+	)
+
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Join adds the user to the link's organization with the link's role and
+// records the use in a single transaction. It returns ErrNotFound if the
+// link was disabled, expired or used up since it was fetched, and
+// ErrDuplicateMember if the user is already a member.
+func (s *OrganizationJoinLinkStore) Join(ctx context.Context, link *models.OrganizationJoinLink, member *models.OrganizationMember) error {
+	return WithTx(s.db, ctx, func(tx *sql.Tx) error {
+		if err := useOrgJoinLinkTx(ctx, tx, link); err != nil {
+			return err
+		}
+
+		member.OrganizationID = link.OrganizationID
+		member.RoleID = link.RoleID
+		if err := createOrgMemberTx(ctx, tx, member); err != nil {
+			return err
+		}
+
+		return createOrgJoinLinkUseTx(ctx, tx, link, member)
+	})
+}
+
+// useOrgJoinLinkTx counts a use of the link. The checks are made in the
+// update so that concurrent uses can not go past the link's maximum.
+func useOrgJoinLinkTx(ctx context.Context, tx *sql.Tx, link *models.OrganizationJoinLink) error {
+	query := `
+		UPDATE organization_join_links
+		SET uses = uses + 1, version = version + 1
+		WHERE id = $1 AND disabled_at IS NULL AND deleted_at IS NULL
+			AND (max_uses IS NULL OR uses < max_uses)
+			AND (expires_at IS NULL OR expires_at > NOW())
+		RETURNING uses, version, updated_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	err := tx.QueryRowContext(ctx, query, link.ID).Scan(
+		&link.Uses,
+		&link.Version,
+		&link.UpdatedAt,
+	)
+
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+func createOrgJoinLinkUseTx(ctx context.Context, tx *sql.Tx, link *models.OrganizationJoinLink, member *models.OrganizationMember) error {
+	query := `
+		INSERT INTO organization_join_link_uses(link_id, user_id, member_id)
+		VALUES($1, $2, $3)
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	_, err := tx.ExecContext(ctx, query, link.ID, member.UserProfileID, member.ID)
+	return err
+}
+
+func scanOrgJoinLink(row rowScanner) (*models.OrganizationJoinLink, error) {
+	var link models.OrganizationJoinLink
+	err := row.Scan(
+		&link.ID,
+		&link.OrganizationID,
+		&link.RoleID,
+		&link.Token,
+		&link.MaxUses,
+		&link.Uses,
+		&link.ExpiresAt,
+		&link.DisabledAt,
+		&link.CreatedBy,
+		&link.Version,
+		&link.CreatedAt,
+		&link.UpdatedAt,
+		&link.DeletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &link, nil
+}
@@ -3,18 +3,66 @@ package store
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/KengoWada/meetup-clone/internal/models"
 )
 
+var (
+	ErrLastOwner       = errors.New("member is the last owner of the organization")
+	ErrDuplicateMember = errors.New("user is already a member of the organization")
+)
+
 const createOrgMemberQuery = `
-	INSERT INTO organization_members(org_id, user_id, role_id)
-	VALUES($1, $2, $3)
+	INSERT INTO organization_members(org_id, user_id, role_id, is_owner)
+	VALUES($1, $2, $3, $4)
 	RETURNING id, version, created_at, updated_at, deleted_at
 `
 
+const softDeleteOrgMemberQuery = `
+	UPDATE organization_members
+	SET deleted_at = NOW(), version = version + 1
+	WHERE id = $1 AND version = $2 AND deleted_at IS NULL
+	RETURNING version, updated_at, deleted_at
+`
+
+// The fields members can be sorted by.
+const (
+	MemberSortJoinedAt = "joinedAt"
+	MemberSortUsername = "username"
+)
+
+// memberSortColumns are the columns members can be sorted by, along with
+// the type their cursor values are cast to.
+var memberSortColumns = map[string][2]string{
+	MemberSortJoinedAt: {"m.created_at", "TIMESTAMPTZ"},
+	MemberSortUsername: {"p.username", "TEXT"},
+}
+
+// MemberCursor is the position of a member in a list of members sorted by
+// Sort and then ID. Value is the member's value of the sorted field.
+type MemberCursor struct {
+	Sort  string `json:"sort"`
+	Value string `json:"value"`
+	ID    int64  `json:"id"`
+}
+
+// MemberFilter narrows down the members returned by GetByOrgID. RoleID and
+// Username are ignored when they are empty. Username matches members whose
+// username starts with it, ignoring case.
+type MemberFilter struct {
+	RoleID   int64
+	Username string
+	Sort     string
+	// Descending sorts the members from the largest value to the smallest.
+	Descending bool
+	// After returns the members that come after the cursor.
+	After *MemberCursor
+	Limit int
+}
+
 type OrganizationMembersStore struct {
 	db *sql.DB
 }
@@ -23,14 +71,16 @@ func (s *OrganizationMembersStore) Create(ctx context.Context, member *models.Or
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
 	defer cancel()
 
-	values := []any{member.OrganizationID, member.UserProfileID, member.RoleID}
-	return s.db.QueryRowContext(ctx, createOrgMemberQuery, values...).Scan(
+	values := []any{member.OrganizationID, member.UserProfileID, member.RoleID, member.IsOwner}
+	err := s.db.QueryRowContext(ctx, createOrgMemberQuery, values...).Scan(
 		&member.ID,
 		&member.Version,
 		&member.CreatedAt,
 		&member.UpdatedAt,
 		&member.DeletedAt,
 	)
+
+	return createOrgMemberErr(err)
 }
 
 func (s *OrganizationMembersStore) Get(ctx context.Context, isDeleted bool, fields []string, values []any) (*models.OrganizationMember, error) {
@@ -45,7 +95,12 @@ func (s *OrganizationMembersStore) Get(ctx context.Context, isDeleted bool, fiel
 	}
 
 	query := fmt.Sprintf(
-		"SELECT * FROM organization_members WHERE %s",
+		`
+			SELECT id, org_id, user_id, role_id, is_owner, version, created_at,
+				updated_at, deleted_at
+			FROM organization_members
+			WHERE %s
+		`,
 		strings.Join(queryConditions, " AND "),
 	)
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
@@ -57,6 +112,7 @@ func (s *OrganizationMembersStore) Get(ctx context.Context, isDeleted bool, fiel
 		&member.OrganizationID,
 		&member.UserProfileID,
 		&member.RoleID,
+		&member.IsOwner,
 		&member.Version,
 		&member.CreatedAt,
 		&member.UpdatedAt,
@@ -75,16 +131,307 @@ func (s *OrganizationMembersStore) Get(ctx context.Context, isDeleted bool, fiel
 	return &member, nil
 }
 
+// UpdateRole changes the member's role.
+func (s *OrganizationMembersStore) UpdateRole(ctx context.Context, member *models.OrganizationMember) error {
+	query := `
+		UPDATE organization_members
+		SET role_id = $1, version = version + 1
+		WHERE id = $2 AND version = $3 AND deleted_at IS NULL
+		RETURNING version, updated_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	err := s.db.QueryRowContext(ctx, query, member.RoleID, member.ID, member.Version).Scan(
+		&member.Version,
+		&member.UpdatedAt,
+	)
+
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SoftDelete removes the member from the organization.
+func (s *OrganizationMembersStore) SoftDelete(ctx context.Context, member *models.OrganizationMember) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	err := s.db.QueryRowContext(ctx, softDeleteOrgMemberQuery, member.ID, member.Version).Scan(
+		&member.Version,
+		&member.UpdatedAt,
+		&member.DeletedAt,
+	)
+
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Leave removes the member from the organization. The organization's
+// owners are locked until the transaction ends so that the last owner can
+// not leave, ErrLastOwner is returned instead.
+func (s *OrganizationMembersStore) Leave(ctx context.Context, member *models.OrganizationMember) error {
+	return WithTx(s.db, ctx, func(tx *sql.Tx) error {
+		if member.IsOwner {
+			owners, err := lockOrgOwnersTx(ctx, tx, member.OrganizationID)
+			if err != nil {
+				return err
+			}
+
+			if owners <= 1 {
+				return ErrLastOwner
+			}
+		}
+
+		return softDeleteOrgMemberTx(ctx, tx, member)
+	})
+}
+
+// TransferOwnership hands the ownership of the organization from one
+// member to another and records the transfer.
+func (s *OrganizationMembersStore) TransferOwnership(ctx context.Context, from, to *models.OrganizationMember) (*models.OwnershipTransfer, error) {
+	transfer := &models.OwnershipTransfer{
+		OrganizationID: from.OrganizationID,
+		FromMemberID:   from.ID,
+		ToMemberID:     to.ID,
+	}
+
+	err := WithTx(s.db, ctx, func(tx *sql.Tx) error {
+		if err := setOrgMemberOwnerTx(ctx, tx, from, false); err != nil {
+			return err
+		}
+
+		if err := setOrgMemberOwnerTx(ctx, tx, to, true); err != nil {
+			return err
+		}
+
+		return createOwnershipTransferTx(ctx, tx, transfer)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+// GetByOrgID returns the members of the organization that match the filter,
+// sorted by the filter's field and then ID so that they can be paged
+// through with a cursor. Members whose role was deleted have no role name.
+func (s *OrganizationMembersStore) GetByOrgID(ctx context.Context, orgID int64, filter MemberFilter) ([]*models.ManagedMember, error) {
+	sortColumn := memberSortColumns[filter.Sort]
+	direction, operator := "ASC", ">"
+	if filter.Descending {
+		direction, operator = "DESC", "<"
+	}
+
+	conditions := []string{"m.org_id = $1", "m.deleted_at IS NULL", "p.deleted_at IS NULL"}
+	values := []any{orgID}
+	addCondition := func(condition string, value any) {
+		values = append(values, value)
+		conditions = append(conditions, fmt.Sprintf(condition, len(values)))
+	}
+
+	if filter.RoleID != 0 {
+		addCondition("m.role_id = $%d", filter.RoleID)
+	}
+	if filter.Username != "" {
+		addCondition("p.username ILIKE $%d", escapeLikePattern(filter.Username)+"%")
+	}
+	if filter.After != nil {
+		values = append(values, filter.After.Value, filter.After.ID)
+		condition := fmt.Sprintf(
+			"(%s, m.id) %s ($%d::%s, $%d)",
+			sortColumn[0], operator, len(values)-1, sortColumn[1], len(values),
+		)
+		conditions = append(conditions, condition)
+	}
+	values = append(values, filter.Limit)
+
+	query := fmt.Sprintf(`
+		SELECT m.id, p.id, p.username, p.profile_pic, u.email, m.role_id,
+			COALESCE(r.name, ''), m.is_owner, m.created_at
+		FROM organization_members m
+		INNER JOIN user_profiles p
+			ON p.id = m.user_id
+		INNER JOIN users u
+			ON u.id = p.user_id
+		LEFT JOIN roles r
+			ON r.id = m.role_id AND r.deleted_at IS NULL
+		WHERE %[2]s
+		ORDER BY %[1]s %[3]s, m.id %[3]s
+		LIMIT $%[4]d
+	`, sortColumn[0], strings.Join(conditions, " AND "), direction, len(values))
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, query, values...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var members []*models.ManagedMember
+	for rows.Next() {
+		var member models.ManagedMember
+		err := rows.Scan(
+			&member.ID,
+			&member.UserProfileID,
+			&member.Username,
+			&member.ProfilePic,
+			&member.Email,
+			&member.RoleID,
+			&member.RoleName,
+			&member.IsOwner,
+			&member.JoinedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, &member)
+	}
+
+	return members, nil
+}
+
+// escapeLikePattern escapes the characters that have a special meaning in
+// LIKE patterns so that value is matched as is.
+func escapeLikePattern(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+	return replacer.Replace(value)
+}
+
 func createOrgMemberTx(ctx context.Context, tx *sql.Tx, member *models.OrganizationMember) error {
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
 	defer cancel()
 
-	values := []any{member.OrganizationID, member.UserProfileID, member.RoleID}
-	return tx.QueryRowContext(ctx, createOrgMemberQuery, values...).Scan(
+	values := []any{member.OrganizationID, member.UserProfileID, member.RoleID, member.IsOwner}
+	err := tx.QueryRowContext(ctx, createOrgMemberQuery, values...).Scan(
 		&member.ID,
 		&member.Version,
 		&member.CreatedAt,
 		&member.UpdatedAt,
 		&member.DeletedAt,
 	)
+
+	return createOrgMemberErr(err)
+}
+
+// createOrgMemberErr maps the error of inserting a member. A user can only
+// be an active member of an organization once, so two requests adding the
+// same user at the same time can not both succeed.
+func createOrgMemberErr(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch err.Error() {
+	case `pq: duplicate key value violates unique constraint "idx_organization_members_org_id_user_id"`:
+		return ErrDuplicateMember
+	default:
+		return err
+	}
+}
+
+func softDeleteOrgMemberTx(ctx context.Context, tx *sql.Tx, member *models.OrganizationMember) error {
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	err := tx.QueryRowContext(ctx, softDeleteOrgMemberQuery, member.ID, member.Version).Scan(
+		&member.Version,
+		&member.UpdatedAt,
+		&member.DeletedAt,
+	)
+
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lockOrgOwnersTx locks the rows of the organization's owners until the
+// transaction ends and returns how many owners there are.
+func lockOrgOwnersTx(ctx context.Context, tx *sql.Tx, orgID int64) (int, error) {
+	query := `
+		SELECT id FROM organization_members
+		WHERE org_id = $1 AND is_owner AND deleted_at IS NULL
+		FOR UPDATE
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := tx.QueryContext(ctx, query, orgID)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	var owners int
+	for rows.Next() {
+		owners++
+	}
+
+	return owners, rows.Err()
+}
+
+func setOrgMemberOwnerTx(ctx context.Context, tx *sql.Tx, member *models.OrganizationMember, isOwner bool) error {
+	query := `
+		UPDATE organization_members
+		SET is_owner = $1, version = version + 1
+		WHERE id = $2 AND version = $3 AND deleted_at IS NULL
+		RETURNING version, updated_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	err := tx.QueryRowContext(ctx, query, isOwner, member.ID, member.Version).Scan(
+		&member.Version,
+		&member.UpdatedAt,
+	)
+
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	member.IsOwner = isOwner
+	return nil
+}
+
+func createOwnershipTransferTx(ctx context.Context, tx *sql.Tx, transfer *models.OwnershipTransfer) error {
+	query := `
+		INSERT INTO organization_ownership_transfers(org_id, from_member_id, to_member_id)
+		VALUES($1, $2, $3)
+		RETURNING id, created_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	values := []any{transfer.OrganizationID, transfer.FromMemberID, transfer.ToMemberID}
+	return tx.QueryRowContext(ctx, query, values...).Scan(&transfer.ID, &transfer.CreatedAt)
 }
@@ -30,6 +30,7 @@ func (s *OrganizationStore) Create(ctx context.Context, organization *models.Org
 
 		member.OrganizationID = organization.ID
 		member.RoleID = role.ID
+		member.IsOwner = true
 		if err := createOrgMemberTx(ctx, tx, member); err != nil {
 			return err
 		}
@@ -39,7 +40,15 @@ func (s *OrganizationStore) Create(ctx context.Context, organization *models.Org
 }
 
 func (s *OrganizationStore) Get(ctx context.Context, isDeleted bool, fields []string, values []any) (*models.Organization, error) {
-	query := fmt.Sprintf("SELECT * FROM organizations WHERE %s", generateQueryConditions(isDeleted, fields))
+	query := fmt.Sprintf(
+		`
+			SELECT id, name, description, profile_pic, is_active, version, created_at,
+				updated_at, deleted_at
+			FROM organizations
+			WHERE %s
+		`,
+		generateQueryConditions(isDeleted, fields),
+	)
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
 	defer cancel()
 
@@ -0,0 +1,120 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/KengoWada/meetup-clone/internal/models"
+)
+
+type RefreshTokenStore struct {
+	db *sql.DB
+}
+
+func (s *RefreshTokenStore) Create(ctx context.Context, token *models.RefreshToken) error {
+	return WithTx(s.db, ctx, func(tx *sql.Tx) error {
+		return createRefreshTokenTx(ctx, tx, token)
+	})
+}
+
+// GetByTokenHash returns the refresh token with the hash, whether or not it
+// has been rotated or revoked.
+func (s *RefreshTokenStore) GetByTokenHash(ctx context.Context, tokenHash string) (*models.RefreshToken, error) {
+	query := `
+		SELECT id, user_id, family_id, token_hash, expires_at, rotated_at, revoked_at, created_at
+		FROM refresh_tokens
+		WHERE token_hash = $1
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var token models.RefreshToken
+	err := s.db.QueryRowContext(ctx, query, tokenHash).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.FamilyID,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&token.RotatedAt,
+		&token.RevokedAt,
+		&token.CreatedAt,
+	)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return nil, ErrNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return &token, nil
+}
+
+// Rotate marks the token as used and creates the next token in its family
+// within a single transaction. It returns ErrNotFound if the token has
+// already been rotated or revoked, which means it was replayed.
+func (s *RefreshTokenStore) Rotate(ctx context.Context, token, next *models.RefreshToken) error {
+	return WithTx(s.db, ctx, func(tx *sql.Tx) error {
+		query := `
+			UPDATE refresh_tokens
+			SET rotated_at = NOW()
+			WHERE id = $1 AND rotated_at IS NULL AND revoked_at IS NULL
+			RETURNING rotated_at
+		`
+		ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+		defer cancel()
+
+		err := tx.QueryRowContext(ctx, query, token.ID).Scan(&token.RotatedAt)
+		if err != nil {
+			switch err {
+			case sql.ErrNoRows:
+				return ErrNotFound
+			default:
+				return err
+			}
+		}
+
+		next.UserID = token.UserID
+		next.FamilyID = token.FamilyID
+		return createRefreshTokenTx(ctx, tx, next)
+	})
+}
+
+// RevokeFamily revokes every token in the family so none of them can be
+// used again.
+func (s *RefreshTokenStore) RevokeFamily(ctx context.Context, familyID string) error {
+	query := `
+		UPDATE refresh_tokens
+		SET revoked_at = NOW()
+		WHERE family_id = $1 AND revoked_at IS NULL
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	_, err := s.db.ExecContext(ctx, query, familyID)
+	return err
+}
+
+func createRefreshTokenTx(ctx context.Context, tx *sql.Tx, token *models.RefreshToken) error {
+	query := `
+		INSERT INTO refresh_tokens(user_id, family_id, token_hash, expires_at)
+		VALUES($1, $2, $3, $4)
+		RETURNING id, expires_at, created_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	return tx.QueryRowContext(
+		ctx,
+		query,
+		token.UserID,
+		token.FamilyID,
+		token.TokenHash,
+		token.ExpiresAt,
+	).Scan(
+		&token.ID,
+		&token.ExpiresAt,
+		&token.CreatedAt,
+	)
+}
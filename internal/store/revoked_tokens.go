@@ -0,0 +1,46 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/KengoWada/meetup-clone/internal/models"
+)
+
+type RevokedTokenStore struct {
+	db *sql.DB
+}
+
+// Create adds the token to the revocation list. Revoking a token that was
+// already revoked is not an error. Tokens that have expired since they were
+// revoked are rejected anyway, so they are removed from the list.
+func (s *RevokedTokenStore) Create(ctx context.Context, token *models.RevokedToken) error {
+	query := `
+		WITH expired AS (
+			DELETE FROM revoked_tokens WHERE expires_at < NOW() AND jti <> $1
+		)
+		INSERT INTO revoked_tokens(jti, user_id, expires_at)
+		VALUES($1, $2, $3)
+		ON CONFLICT (jti) DO UPDATE SET jti = EXCLUDED.jti
+		RETURNING created_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	return s.db.QueryRowContext(ctx, query, token.JTI, token.UserID, token.ExpiresAt).Scan(&token.CreatedAt)
+}
+
+// IsRevoked reports whether the token with the jti is on the revocation
+// list.
+func (s *RevokedTokenStore) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM revoked_tokens WHERE jti = $1)`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var isRevoked bool
+	if err := s.db.QueryRowContext(ctx, query, jti).Scan(&isRevoked); err != nil {
+		return false, err
+	}
+
+	return isRevoked, nil
+}
@@ -175,6 +175,116 @@ func (s *RoleStore) SoftDelete(ctx context.Context, role *models.Role) error {
 	return nil
 }
 
+// SoftDeleteAndReassign moves the members holding the role over to the
+// replacement role and deletes the role in one transaction. It returns the
+// user profile IDs of the members that were moved, or ErrNotFound if the
+// replacement role was deleted since it was fetched.
+func (s *RoleStore) SoftDeleteAndReassign(ctx context.Context, role *models.Role, replacementID int64) ([]int64, error) {
+	var userIDs []int64
+	err := WithTx(s.db, ctx, func(tx *sql.Tx) error {
+		if err := lockRoleTx(ctx, tx, replacementID, role.OrganizationID); err != nil {
+			return err
+		}
+
+		var err error
+		userIDs, err = reassignOrgMembersTx(ctx, tx, role.ID, replacementID)
+		if err != nil {
+			return err
+		}
+
+		return softDeleteRoleTx(ctx, tx, role)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+func softDeleteRoleTx(ctx context.Context, tx *sql.Tx, role *models.Role) error {
+	query := `
+		UPDATE roles
+		SET deleted_at = NOW(), version = version + 1
+		WHERE id = $1 AND version = $2 AND deleted_at IS NULL
+		RETURNING version, updated_at, deleted_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	err := tx.QueryRowContext(ctx, query, role.ID, role.Version).Scan(
+		&role.Version,
+		&role.UpdatedAt,
+		&role.DeletedAt,
+	)
+
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lockRoleTx locks the active role until the transaction ends, so that it
+// can not be deleted while members are moved to it.
+func lockRoleTx(ctx context.Context, tx *sql.Tx, roleID, orgID int64) error {
+	query := `
+		SELECT id FROM roles
+		WHERE id = $1 AND org_id = $2 AND deleted_at IS NULL
+		FOR UPDATE
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var id int64
+	err := tx.QueryRowContext(ctx, query, roleID, orgID).Scan(&id)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reassignOrgMembersTx moves the members holding the role over to the
+// replacement role and returns their user profile IDs.
+func reassignOrgMembersTx(ctx context.Context, tx *sql.Tx, roleID, replacementID int64) ([]int64, error) {
+	query := `
+		UPDATE organization_members
+		SET role_id = $1, version = version + 1
+		WHERE role_id = $2 AND deleted_at IS NULL
+		RETURNING user_id
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := tx.QueryContext(ctx, query, replacementID, roleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var userIDs []int64
+	for rows.Next() {
+		var userID int64
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+
+		userIDs = append(userIDs, userID)
+	}
+
+	return userIDs, rows.Err()
+}
+
 func createRoleTx(ctx context.Context, tx *sql.Tx, role *models.Role) error {
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
 	defer cancel()
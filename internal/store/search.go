@@ -0,0 +1,111 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/KengoWada/meetup-clone/internal/models"
+)
+
+const (
+	// highlightStart and highlightStop wrap the matching words in search
+	// snippets until the snippets are escaped. They are control characters
+	// so that they can not be confused with the text being searched.
+	highlightStart = "\x02"
+	highlightStop  = "\x03"
+)
+
+// searchQueries select the results of each type of search. $1 is the search
+// text and $2 the options passed to ts_headline.
+var searchQueries = map[models.SearchType]string{
+	models.SearchOrganizations: `
+		SELECT 'organizations', o.id, o.name,
+			ts_headline('english', o.name || ': ' || o.description, q, $2),
+			ts_rank(o.search_vector, q)
+		FROM organizations o, websearch_to_tsquery('english', $1) q
+		WHERE o.search_vector @@ q AND o.is_active AND o.deleted_at IS NULL
+	`,
+	models.SearchEvents: `
+		SELECT 'events', e.id, e.title,
+			ts_headline('english', e.title || ': ' || e.description, q, $2),
+			ts_rank(e.search_vector, q)
+		FROM events e
+		JOIN organizations o ON o.id = e.org_id, websearch_to_tsquery('english', $1) q
+		WHERE e.search_vector @@ q AND e.published_at IS NOT NULL
+			AND e.cancelled_at IS NULL AND e.deleted_at IS NULL
+			AND o.is_active AND o.deleted_at IS NULL
+	`,
+	models.SearchUsers: `
+		SELECT 'users', p.id, p.username,
+			ts_headline('simple', p.username, q, $2),
+			ts_rank(p.search_vector, q)
+		FROM user_profiles p
+		JOIN users u ON u.id = p.user_id, websearch_to_tsquery('simple', $1) q
+		WHERE p.search_vector @@ q AND p.deleted_at IS NULL
+			AND u.is_active AND u.deleted_at IS NULL
+	`,
+}
+
+type SearchStore struct {
+	db *sql.DB
+}
+
+// Search returns the results of the given types that match the text, best
+// match first. The text supports the web search syntax of Postgres, such as
+// quoted phrases, OR, and - to leave out words.
+func (s *SearchStore) Search(ctx context.Context, text string, types []models.SearchType, limit int) ([]*models.SearchResult, error) {
+	var queries []string
+	for _, searchType := range types {
+		queries = append(queries, fmt.Sprintf("(%s)", searchQueries[searchType]))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT type, id, title, snippet, rank
+		FROM (%s) AS results(type, id, title, snippet, rank)
+		ORDER BY rank DESC, type ASC, id ASC
+		LIMIT $3
+	`, strings.Join(queries, " UNION ALL "))
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	options := fmt.Sprintf(
+		"StartSel=%s, StopSel=%s, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=\" ... \"",
+		highlightStart,
+		highlightStop,
+	)
+	rows, err := s.db.QueryContext(ctx, query, text, options, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*models.SearchResult
+	for rows.Next() {
+		var result models.SearchResult
+		err := rows.Scan(
+			&result.Type,
+			&result.ID,
+			&result.Title,
+			&result.Snippet,
+			&result.Rank,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Snippet = highlight(result.Snippet)
+		results = append(results, &result)
+	}
+
+	return results, nil
+}
+
+// highlight escapes the snippet so that it is safe to render as HTML and
+// wraps the matching words in <mark> tags.
+func highlight(snippet string) string {
+	replacer := strings.NewReplacer(highlightStart, "<mark>", highlightStop, "</mark>")
+	return replacer.Replace(html.EscapeString(snippet))
+}
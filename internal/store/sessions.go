@@ -0,0 +1,233 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/KengoWada/meetup-clone/internal/models"
+)
+
+const userSessionColumns = `
+	id, user_id, token_family_id, device, ip_address, user_agent,
+	last_seen_at, revoked_at, created_at
+`
+
+type UserSessionStore struct {
+	db *sql.DB
+}
+
+// Create starts a new session along with the first refresh token of its
+// family within a single transaction.
+func (s *UserSessionStore) Create(ctx context.Context, session *models.UserSession, token *models.RefreshToken) error {
+	return WithTx(s.db, ctx, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO user_sessions(user_id, token_family_id, device, ip_address, user_agent)
+			VALUES($1, $2, $3, $4, $5)
+			RETURNING id, last_seen_at, created_at
+		`
+		ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+		defer cancel()
+
+		err := tx.QueryRowContext(
+			ctx,
+			query,
+			session.UserID,
+			session.TokenFamilyID,
+			session.Device,
+			session.IPAddress,
+			session.UserAgent,
+		).Scan(
+			&session.ID,
+			&session.LastSeenAt,
+			&session.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		token.UserID = session.UserID
+		token.FamilyID = session.TokenFamilyID
+		return createRefreshTokenTx(ctx, tx, token)
+	})
+}
+
+func (s *UserSessionStore) GetByID(ctx context.Context, id int64) (*models.UserSession, error) {
+	return s.get(ctx, "id = $1", id)
+}
+
+func (s *UserSessionStore) GetByTokenFamilyID(ctx context.Context, familyID string) (*models.UserSession, error) {
+	return s.get(ctx, "token_family_id = $1", familyID)
+}
+
+// GetActiveByUserID returns the user's sessions that have not been revoked
+// and can still be refreshed, most recently used first.
+func (s *UserSessionStore) GetActiveByUserID(ctx context.Context, userID int64) ([]*models.UserSession, error) {
+	query := fmt.Sprintf(`
+		SELECT %s FROM user_sessions s
+		WHERE s.user_id = $1 AND s.revoked_at IS NULL
+		AND EXISTS (
+			SELECT 1 FROM refresh_tokens rt
+			WHERE rt.family_id = s.token_family_id
+			AND rt.rotated_at IS NULL AND rt.revoked_at IS NULL AND rt.expires_at > NOW()
+		)
+		ORDER BY s.last_seen_at DESC, s.id DESC
+	`, userSessionColumns)
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []*models.UserSession
+	for rows.Next() {
+		session, err := scanUserSession(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+// Touch records that the session was just used. Revoked sessions are not
+// updated and return ErrNotFound.
+func (s *UserSessionStore) Touch(ctx context.Context, session *models.UserSession) error {
+	query := `
+		UPDATE user_sessions
+		SET last_seen_at = NOW()
+		WHERE id = $1 AND revoked_at IS NULL
+		RETURNING last_seen_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	err := s.db.QueryRowContext(ctx, query, session.ID).Scan(&session.LastSeenAt)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Revoke ends the session and revokes its refresh tokens within a single
+// transaction. Revoking a session that was already revoked is not an error.
+func (s *UserSessionStore) Revoke(ctx context.Context, session *models.UserSession) error {
+	return WithTx(s.db, ctx, func(tx *sql.Tx) error {
+		query := `
+			UPDATE user_sessions
+			SET revoked_at = COALESCE(revoked_at, NOW())
+			WHERE id = $1
+			RETURNING revoked_at
+		`
+		ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+		defer cancel()
+
+		if err := tx.QueryRowContext(ctx, query, session.ID).Scan(&session.RevokedAt); err != nil {
+			return err
+		}
+
+		query = `
+			UPDATE refresh_tokens
+			SET revoked_at = NOW()
+			WHERE family_id = $1 AND revoked_at IS NULL
+		`
+		_, err := tx.ExecContext(ctx, query, session.TokenFamilyID)
+		return err
+	})
+}
+
+// RevokeOthers ends every session of the user except the current one and
+// revokes their refresh tokens within a single transaction. It returns the
+// IDs of the sessions that were revoked.
+func (s *UserSessionStore) RevokeOthers(ctx context.Context, userID, currentID int64) ([]int64, error) {
+	var sessionIDs []int64
+	err := WithTx(s.db, ctx, func(tx *sql.Tx) error {
+		query := `
+			UPDATE user_sessions
+			SET revoked_at = NOW()
+			WHERE user_id = $1 AND id <> $2 AND revoked_at IS NULL
+			RETURNING id
+		`
+		ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+		defer cancel()
+
+		rows, err := tx.QueryContext(ctx, query, userID, currentID)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var id int64
+			if err := rows.Scan(&id); err != nil {
+				return err
+			}
+			sessionIDs = append(sessionIDs, id)
+		}
+
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		query = `
+			UPDATE refresh_tokens
+			SET revoked_at = NOW()
+			WHERE user_id = $1 AND revoked_at IS NULL AND family_id IN (
+				SELECT token_family_id FROM user_sessions WHERE user_id = $1 AND id <> $2
+			)
+		`
+		_, err = tx.ExecContext(ctx, query, userID, currentID)
+		return err
+	})
+
+	return sessionIDs, err
+}
+
+func (s *UserSessionStore) get(ctx context.Context, condition string, value any) (*models.UserSession, error) {
+	query := fmt.Sprintf("SELECT %s FROM user_sessions WHERE %s", userSessionColumns, condition)
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	session, err := scanUserSession(s.db.QueryRowContext(ctx, query, value))
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return nil, ErrNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return session, nil
+}
+
+func scanUserSession(row rowScanner) (*models.UserSession, error) {
+	var session models.UserSession
+	err := row.Scan(
+		&session.ID,
+		&session.UserID,
+		&session.TokenFamilyID,
+		&session.Device,
+		&session.IPAddress,
+		&session.UserAgent,
+		&session.LastSeenAt,
+		&session.RevokedAt,
+		&session.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
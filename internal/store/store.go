@@ -34,6 +34,41 @@ type Store struct {
 		Get(ctx context.Context, isDeleted bool, fields []string, values []any) (*models.User, error)
 		GetWithProfile(ctx context.Context, isDeleted bool, fields []string, values []any) (*models.User, error)
 	}
+	RefreshTokens interface {
+		Create(ctx context.Context, token *models.RefreshToken) error
+		GetByTokenHash(ctx context.Context, tokenHash string) (*models.RefreshToken, error)
+		Rotate(ctx context.Context, token, next *models.RefreshToken) error
+		RevokeFamily(ctx context.Context, familyID string) error
+	}
+	RevokedTokens interface {
+		Create(ctx context.Context, token *models.RevokedToken) error
+		IsRevoked(ctx context.Context, jti string) (bool, error)
+	}
+	Sessions interface {
+		Create(ctx context.Context, session *models.UserSession, token *models.RefreshToken) error
+		GetByID(ctx context.Context, id int64) (*models.UserSession, error)
+		GetByTokenFamilyID(ctx context.Context, familyID string) (*models.UserSession, error)
+		GetActiveByUserID(ctx context.Context, userID int64) ([]*models.UserSession, error)
+		Touch(ctx context.Context, session *models.UserSession) error
+		Revoke(ctx context.Context, session *models.UserSession) error
+		RevokeOthers(ctx context.Context, userID, currentID int64) ([]int64, error)
+	}
+	TwoFactor interface {
+		Get(ctx context.Context, userID int64) (*models.TwoFactor, error)
+		CreatePending(ctx context.Context, twoFactor *models.TwoFactor) error
+		Enable(ctx context.Context, twoFactor *models.TwoFactor, step int64, recoveryCodeHashes []string) error
+		Disable(ctx context.Context, userID int64) error
+		UseStep(ctx context.Context, twoFactor *models.TwoFactor, step int64) error
+		UseRecoveryCode(ctx context.Context, userID int64, codeHash string) error
+		RecordAttempt(ctx context.Context, twoFactor *models.TwoFactor, window time.Duration) error
+		ResetAttempts(ctx context.Context, twoFactor *models.TwoFactor) error
+	}
+	LoginChallenges interface {
+		Create(ctx context.Context, challenge *models.LoginChallenge) error
+		GetByTokenHash(ctx context.Context, tokenHash string) (*models.LoginChallenge, error)
+		RecordAttempt(ctx context.Context, challenge *models.LoginChallenge) error
+		Use(ctx context.Context, challenge *models.LoginChallenge) error
+	}
 	Organizations interface {
 		Create(ctx context.Context, organization *models.Organization, role *models.Role, member *models.OrganizationMember) error
 		Get(ctx context.Context, isDeleted bool, fields []string, values []any) (*models.Organization, error)
@@ -48,24 +83,101 @@ type Store struct {
 		GetByOrgID(ctx context.Context, orgID int64) ([]*models.SimpleRole, error)
 		Update(ctx context.Context, role *models.Role) error
 		SoftDelete(ctx context.Context, role *models.Role) error
+		SoftDeleteAndReassign(ctx context.Context, role *models.Role, replacementID int64) ([]int64, error)
 	}
 	OrganizationMembers interface {
 		Create(ctx context.Context, member *models.OrganizationMember) error
 		Get(ctx context.Context, isDeleted bool, fields []string, values []any) (*models.OrganizationMember, error)
+		GetByOrgID(ctx context.Context, orgID int64, filter MemberFilter) ([]*models.ManagedMember, error)
+		UpdateRole(ctx context.Context, member *models.OrganizationMember) error
+		SoftDelete(ctx context.Context, member *models.OrganizationMember) error
+		Leave(ctx context.Context, member *models.OrganizationMember) error
+		TransferOwnership(ctx context.Context, from, to *models.OrganizationMember) (*models.OwnershipTransfer, error)
 	}
 	OrganizationInvites interface {
 		Create(ctx context.Context, invite *models.OrganizationInvite) error
 		Get(ctx context.Context, isDeleted bool, fields []string, values []any) (*models.OrganizationInvite, error)
+		GetPendingByUserID(ctx context.Context, userID int64) ([]*models.PendingInvite, error)
+		GetPendingByOrgID(ctx context.Context, orgID int64) ([]*models.SentInvite, error)
+		Resend(ctx context.Context, invite *models.OrganizationInvite) error
+		Revoke(ctx context.Context, invite *models.OrganizationInvite) error
+		Accept(ctx context.Context, invite *models.OrganizationInvite, member *models.OrganizationMember) error
+		Decline(ctx context.Context, invite *models.OrganizationInvite) error
+	}
+	OrganizationJoinLinks interface {
+		Create(ctx context.Context, link *models.OrganizationJoinLink) error
+		Get(ctx context.Context, isDeleted bool, fields []string, values []any) (*models.OrganizationJoinLink, error)
+		GetByOrgID(ctx context.Context, orgID int64) ([]*models.OrganizationJoinLink, error)
+		Disable(ctx context.Context, link *models.OrganizationJoinLink) error
+		Join(ctx context.Context, link *models.OrganizationJoinLink, member *models.OrganizationMember) error
+	}
+	Venues interface {
+		Create(ctx context.Context, venue *models.Venue) error
+		Get(ctx context.Context, isDeleted bool, fields []string, values []any) (*models.Venue, error)
+		GetByOrgID(ctx context.Context, orgID int64) ([]*models.SimpleVenue, error)
+		Update(ctx context.Context, venue *models.Venue) error
+		SoftDelete(ctx context.Context, venue *models.Venue) error
+	}
+	Events interface {
+		Create(ctx context.Context, event *models.Event) error
+		Get(ctx context.Context, isDeleted bool, fields []string, values []any) (*models.Event, error)
+		GetByOrgID(ctx context.Context, orgID int64, includeDrafts bool) ([]*models.SimpleEvent, error)
+		GetPublishedByOrgID(ctx context.Context, orgID int64) ([]*models.Event, error)
+		GetPublished(ctx context.Context, filter EventFilter) ([]*models.FeedEvent, error)
+		GetNearby(ctx context.Context, latitude, longitude, radiusKm float64) ([]*models.NearbyEvent, error)
+		Update(ctx context.Context, event *models.Event) error
+		Publish(ctx context.Context, event *models.Event, userProfileID int64) error
+		Cancel(ctx context.Context, event *models.Event, notification *models.Notification) error
+		SplitSeries(ctx context.Context, event, newEvent *models.Event, occurrenceStart string) error
+		SoftDelete(ctx context.Context, event *models.Event) error
+		HasRSVPsOrOverrides(ctx context.Context, eventID int64) (bool, error)
+	}
+	EventOccurrenceOverrides interface {
+		Save(ctx context.Context, override *models.EventOccurrenceOverride) error
+		GetByEventID(ctx context.Context, eventID int64) ([]*models.EventOccurrenceOverride, error)
+		GetByEventIDs(ctx context.Context, eventIDs []int64) ([]*models.EventOccurrenceOverride, error)
+	}
+	EventRSVPs interface {
+		Create(ctx context.Context, rsvp *models.EventRSVP) error
+		Get(ctx context.Context, isDeleted bool, fields []string, values []any) (*models.EventRSVP, error)
+		GetByEventID(ctx context.Context, eventID int64, occurrenceStart *string) ([]*models.EventAttendee, error)
+		GetByUserID(ctx context.Context, userID int64) ([]*models.EventRSVP, error)
+		Respond(ctx context.Context, rsvp *models.EventRSVP, notification *models.Notification) error
+		CheckIn(ctx context.Context, rsvp *models.EventRSVP, memberID int64) error
+	}
+	Notifications interface {
+		GetByUserID(ctx context.Context, userID int64) ([]*models.Notification, error)
+	}
+	CalendarFeeds interface {
+		Create(ctx context.Context, feed *models.CalendarFeed) error
+		Get(ctx context.Context, isDeleted bool, fields []string, values []any) (*models.CalendarFeed, error)
+		SoftDelete(ctx context.Context, feed *models.CalendarFeed) error
+	}
+	Search interface {
+		Search(ctx context.Context, text string, types []models.SearchType, limit int) ([]*models.SearchResult, error)
 	}
 }
 
 func NewStore(db *sql.DB) Store {
 	return Store{
-		Users:               &UserStore{db},
-		Organizations:       &OrganizationStore{db},
-		Roles:               &RoleStore{db},
-		OrganizationMembers: &OrganizationMembersStore{db},
-		OrganizationInvites: &OrganizationInviteStore{db},
+		Users:                    &UserStore{db},
+		RefreshTokens:            &RefreshTokenStore{db},
+		RevokedTokens:            &RevokedTokenStore{db},
+		Sessions:                 &UserSessionStore{db},
+		TwoFactor:                &TwoFactorStore{db},
+		LoginChallenges:          &LoginChallengeStore{db},
+		Organizations:            &OrganizationStore{db},
+		Roles:                    &RoleStore{db},
+		OrganizationMembers:      &OrganizationMembersStore{db},
+		OrganizationInvites:      &OrganizationInviteStore{db},
+		OrganizationJoinLinks:    &OrganizationJoinLinkStore{db},
+		Venues:                   &VenueStore{db},
+		Events:                   &EventStore{db},
+		EventOccurrenceOverrides: &EventOccurrenceOverrideStore{db},
+		EventRSVPs:               &EventRSVPStore{db},
+		Notifications:            &NotificationStore{db},
+		CalendarFeeds:            &CalendarFeedStore{db},
+		Search:                   &SearchStore{db},
 	}
 }
 
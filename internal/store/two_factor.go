@@ -0,0 +1,244 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal/models"
+)
+
+type TwoFactorStore struct {
+	db *sql.DB
+}
+
+// Get returns the user's two-factor settings, whether or not they have
+// been confirmed.
+func (s *TwoFactorStore) Get(ctx context.Context, userID int64) (*models.TwoFactor, error) {
+	query := `
+		SELECT user_id, secret, last_used_step, attempts, last_attempt_at, enabled_at, created_at
+		FROM user_two_factor
+		WHERE user_id = $1
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var twoFactor models.TwoFactor
+	err := s.db.QueryRowContext(ctx, query, userID).Scan(
+		&twoFactor.UserID,
+		&twoFactor.Secret,
+		&twoFactor.LastUsedStep,
+		&twoFactor.Attempts,
+		&twoFactor.LastAttemptAt,
+		&twoFactor.EnabledAt,
+		&twoFactor.CreatedAt,
+	)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return nil, ErrNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return &twoFactor, nil
+}
+
+// CreatePending starts a new enrollment with the secret, replacing any
+// enrollment the user did not confirm. It returns ErrNotFound if the user
+// already has two-factor authentication enabled.
+func (s *TwoFactorStore) CreatePending(ctx context.Context, twoFactor *models.TwoFactor) error {
+	query := `
+		INSERT INTO user_two_factor(user_id, secret)
+		VALUES($1, $2)
+		ON CONFLICT (user_id) DO UPDATE
+		SET secret = EXCLUDED.secret, last_used_step = 0, attempts = 0, last_attempt_at = NULL, created_at = NOW()
+		WHERE user_two_factor.enabled_at IS NULL
+		RETURNING last_used_step, enabled_at, created_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	err := s.db.QueryRowContext(ctx, query, twoFactor.UserID, twoFactor.Secret).Scan(
+		&twoFactor.LastUsedStep,
+		&twoFactor.EnabledAt,
+		&twoFactor.CreatedAt,
+	)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Enable confirms the enrollment with the code from the time step and
+// stores the recovery code hashes within a single transaction. It returns
+// ErrNotFound if the enrollment was already confirmed or the code from the
+// time step was already used.
+func (s *TwoFactorStore) Enable(ctx context.Context, twoFactor *models.TwoFactor, step int64, recoveryCodeHashes []string) error {
+	return WithTx(s.db, ctx, func(tx *sql.Tx) error {
+		query := `
+			UPDATE user_two_factor
+			SET enabled_at = NOW(), last_used_step = $2
+			WHERE user_id = $1 AND enabled_at IS NULL AND last_used_step < $2
+			RETURNING last_used_step, enabled_at
+		`
+		ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+		defer cancel()
+
+		err := tx.QueryRowContext(ctx, query, twoFactor.UserID, step).Scan(
+			&twoFactor.LastUsedStep,
+			&twoFactor.EnabledAt,
+		)
+		if err != nil {
+			switch err {
+			case sql.ErrNoRows:
+				return ErrNotFound
+			default:
+				return err
+			}
+		}
+
+		query = `DELETE FROM two_factor_recovery_codes WHERE user_id = $1`
+		if _, err := tx.ExecContext(ctx, query, twoFactor.UserID); err != nil {
+			return err
+		}
+
+		query = `INSERT INTO two_factor_recovery_codes(user_id, code_hash) VALUES($1, $2)`
+		for _, codeHash := range recoveryCodeHashes {
+			if _, err := tx.ExecContext(ctx, query, twoFactor.UserID, codeHash); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Disable removes the user's two-factor settings and recovery codes within
+// a single transaction.
+func (s *TwoFactorStore) Disable(ctx context.Context, userID int64) error {
+	return WithTx(s.db, ctx, func(tx *sql.Tx) error {
+		ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+		defer cancel()
+
+		query := `DELETE FROM two_factor_recovery_codes WHERE user_id = $1`
+		if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+			return err
+		}
+
+		query = `DELETE FROM user_two_factor WHERE user_id = $1`
+		_, err := tx.ExecContext(ctx, query, userID)
+		return err
+	})
+}
+
+// UseStep records that the code from the time step was accepted. It
+// returns ErrNotFound if a code from the same or a later time step was
+// already accepted, which means the code was replayed.
+func (s *TwoFactorStore) UseStep(ctx context.Context, twoFactor *models.TwoFactor, step int64) error {
+	query := `
+		UPDATE user_two_factor
+		SET last_used_step = $2
+		WHERE user_id = $1 AND last_used_step < $2
+		RETURNING last_used_step
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	err := s.db.QueryRowContext(ctx, query, twoFactor.UserID, step).Scan(&twoFactor.LastUsedStep)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RecordAttempt counts an attempt to use a code for the user. Attempts are
+// counted afresh when there has been none within the window. It returns
+// ErrNotFound if the user has no two-factor settings.
+func (s *TwoFactorStore) RecordAttempt(ctx context.Context, twoFactor *models.TwoFactor, window time.Duration) error {
+	query := `
+		UPDATE user_two_factor
+		SET attempts = CASE
+				WHEN last_attempt_at > NOW() - MAKE_INTERVAL(secs => $2) THEN attempts + 1
+				ELSE 1
+			END,
+			last_attempt_at = NOW()
+		WHERE user_id = $1
+		RETURNING attempts, last_attempt_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	err := s.db.QueryRowContext(ctx, query, twoFactor.UserID, window.Seconds()).Scan(
+		&twoFactor.Attempts,
+		&twoFactor.LastAttemptAt,
+	)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResetAttempts clears the user's attempts once a code was accepted.
+func (s *TwoFactorStore) ResetAttempts(ctx context.Context, twoFactor *models.TwoFactor) error {
+	query := `
+		UPDATE user_two_factor
+		SET attempts = 0
+		WHERE user_id = $1
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	if _, err := s.db.ExecContext(ctx, query, twoFactor.UserID); err != nil {
+		return err
+	}
+
+	twoFactor.Attempts = 0
+	return nil
+}
+
+// UseRecoveryCode marks the user's recovery code with the hash as used. It
+// returns ErrNotFound if the user has no unused recovery code with the
+// hash.
+func (s *TwoFactorStore) UseRecoveryCode(ctx context.Context, userID int64, codeHash string) error {
+	query := `
+		UPDATE two_factor_recovery_codes
+		SET used_at = NOW()
+		WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL
+		RETURNING id
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var id int64
+	err := s.db.QueryRowContext(ctx, query, userID, codeHash).Scan(&id)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
@@ -17,6 +17,16 @@ var (
 	ErrDuplicateUsername = errors.New("username is already taken")
 )
 
+const userColumns = `
+	id, email, password, is_active, activated_at, role, password_reset_token,
+	version, created_at, updated_at, deleted_at, tokens_valid_after
+`
+
+// tokensValidAfterNow is the time from which tokens revoked now are valid
+// again. Token issue times are only precise to the second, so it is rounded
+// up to the next second to also revoke tokens issued earlier in this one.
+const tokensValidAfterNow = `DATE_TRUNC('second', NOW()) + INTERVAL '1 second'`
+
 // UserStore provides methods for interacting with the database related to
 // user operations, such as creating, updating, and retrieving users.
 // It encapsulates the database connection and contains methods to perform
@@ -45,41 +55,18 @@ func (s *UserStore) Create(ctx context.Context, user *models.User, userProfile *
 }
 
 // Activate activates a user account in the database. It updates the user's
-// status to active and sets the activation timestamp. If the operation is
-// successful, it returns nil; otherwise, it returns an error.
+// status to active and sets the activation timestamp. Pending organization
+// invites sent to the user's email address are attached to their profile in
+// the same transaction. If the operation is successful, it returns nil;
+// otherwise, it returns an error.
 func (s *UserStore) Activate(ctx context.Context, user *models.User) error {
-	query := `
-		UPDATE users
-		SET is_active = 't', activated_at = $1, version = version + 1
-		WHERE id = $2 AND version = $3
-		RETURNING version, is_active, activated_at, updated_at
-	`
-	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
-	defer cancel()
-
-	timeNow := time.Now().UTC().Format(internal.DateTimeFormat)
-	err := s.db.QueryRowContext(
-		ctx,
-		query,
-		&timeNow,
-		user.ID,
-		user.Version,
-	).Scan(
-		&user.Version,
-		&user.IsActive,
-		&user.ActivatedAt,
-		&user.UpdatedAt,
-	)
-	if err != nil {
-		switch err {
-		case sql.ErrNoRows:
-			return ErrNotFound
-		default:
+	return WithTx(s.db, ctx, func(tx *sql.Tx) error {
+		if err := s.activateUser(ctx, tx, user); err != nil {
 			return err
 		}
-	}
 
-	return nil
+		return attachOrgInvitesTx(ctx, tx, user)
+	})
 }
 
 // Deactivate deactivates a user by setting the IsActive field to false and
@@ -118,16 +105,16 @@ func (s *UserStore) Deactivate(ctx context.Context, user *models.User) error {
 // Returns:
 //   - error: An error if updating the password in the database fails, or nil on success.
 func (s *UserStore) ResetPassword(ctx context.Context, user *models.User) error {
-	query := `
+	query := fmt.Sprintf(`
 		UPDATE users
-		SET password = $1, password_reset_token = $2, version = version + 1
+		SET password = $1, password_reset_token = $2, tokens_valid_after = %s, version = version + 1
 		WHERE id = $3 AND version = $4
-		RETURNING version, updated_at
-	`
+		RETURNING version, tokens_valid_after, updated_at
+	`, tokensValidAfterNow)
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
 	defer cancel()
 
-	err := s.db.QueryRowContext(ctx, query, user.Password, user.PasswordResetToken, user.ID, user.Version).Scan(&user.Version, &user.UpdatedAt)
+	err := s.db.QueryRowContext(ctx, query, user.Password, user.PasswordResetToken, user.ID, user.Version).Scan(&user.Version, &user.TokensValidAfter, &user.UpdatedAt)
 	if err != nil {
 		switch err {
 		case sql.ErrNoRows:
@@ -264,6 +251,43 @@ func (s *UserStore) createUserProfile(ctx context.Context, tx *sql.Tx, userProfi
 	return nil
 }
 
+// activateUser sets the user as active and records when they were activated.
+// This operation is performed within the provided transaction.
+func (s *UserStore) activateUser(ctx context.Context, tx *sql.Tx, user *models.User) error {
+	query := `
+		UPDATE users
+		SET is_active = 't', activated_at = $1, version = version + 1
+		WHERE id = $2 AND version = $3
+		RETURNING version, is_active, activated_at, updated_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	timeNow := time.Now().UTC().Format(internal.DateTimeFormat)
+	err := tx.QueryRowContext(
+		ctx,
+		query,
+		&timeNow,
+		user.ID,
+		user.Version,
+	).Scan(
+		&user.Version,
+		&user.IsActive,
+		&user.ActivatedAt,
+		&user.UpdatedAt,
+	)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
 // deactivateActiveUser is a private method that deactivates a user if the user is currently active.
 // It sets the IsActive field to false.
 // This method is intended to be used when it's confirmed that the user is already active.
@@ -275,12 +299,12 @@ func (s *UserStore) createUserProfile(ctx context.Context, tx *sql.Tx, userProfi
 // Returns:
 //   - error: An error if the operation fails, or nil if the deactivation was successful.
 func (s *UserStore) deactivateActiveUser(ctx context.Context, user *models.User) error {
-	query := `
+	query := fmt.Sprintf(`
 		UPDATE users
-		SET is_active = 'f', version = version + 1
+		SET is_active = 'f', tokens_valid_after = %s, version = version + 1
 		WHERE id = $1 AND version = $2
-		RETURNING version, is_active, updated_at
-	`
+		RETURNING version, is_active, tokens_valid_after, updated_at
+	`, tokensValidAfterNow)
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
 	defer cancel()
 
@@ -292,6 +316,7 @@ func (s *UserStore) deactivateActiveUser(ctx context.Context, user *models.User)
 	).Scan(
 		&user.Version,
 		&user.IsActive,
+		&user.TokensValidAfter,
 		&user.UpdatedAt,
 	)
 	if err != nil {
@@ -317,12 +342,12 @@ func (s *UserStore) deactivateActiveUser(ctx context.Context, user *models.User)
 // Returns:
 //   - error: An error if the operation fails, or nil if the deactivation was successful.
 func (s *UserStore) deactivateInActiveUser(ctx context.Context, user *models.User) error {
-	query := `
+	query := fmt.Sprintf(`
 		UPDATE users
-		SET is_active = 'f', activated_at = $1 version = version + 1
+		SET is_active = 'f', activated_at = $1, tokens_valid_after = %s, version = version + 1
 		WHERE id = $2 AND version = $3
-		RETURNING version, is_active, activated_at, updated_at
-	`
+		RETURNING version, is_active, activated_at, tokens_valid_after, updated_at
+	`, tokensValidAfterNow)
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
 	defer cancel()
 
@@ -336,6 +361,7 @@ func (s *UserStore) deactivateInActiveUser(ctx context.Context, user *models.Use
 		&user.Version,
 		&user.IsActive,
 		&user.ActivatedAt,
+		&user.TokensValidAfter,
 		&user.UpdatedAt,
 	)
 	if err != nil {
@@ -480,7 +506,7 @@ func (s *UserStore) Get(ctx context.Context, isDeleted bool, fields []string, va
 		queryConditions = append(queryConditions, "deleted_at IS NULL")
 	}
 
-	query := fmt.Sprintf("SELECT * FROM users WHERE %s", strings.Join(queryConditions, " AND "))
+	query := fmt.Sprintf("SELECT %s FROM users WHERE %s", userColumns, strings.Join(queryConditions, " AND "))
 	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
 	defer cancel()
 
@@ -497,6 +523,7 @@ func (s *UserStore) Get(ctx context.Context, isDeleted bool, fields []string, va
 		&user.CreatedAt,
 		&user.UpdatedAt,
 		&user.DeletedAt,
+		&user.TokensValidAfter,
 	)
 
 	if err != nil {
@@ -551,7 +578,11 @@ func (s *UserStore) GetWithProfile(ctx context.Context, isDeleted bool, fields [
 
 	query := fmt.Sprintf(
 		`
-			SELECT u.*, up.* FROM users u
+			SELECT u.id, u.email, u.password, u.is_active, u.activated_at, u.role,
+				u.password_reset_token, u.version, u.created_at, u.updated_at, u.deleted_at,
+				u.tokens_valid_after, up.id, up.username, up.profile_pic, up.date_of_birth,
+				up.user_id, up.version, up.created_at, up.updated_at, up.deleted_at
+			FROM users u
 			INNER JOIN user_profiles up
 			ON u.id = up.user_id
 			WHERE %s
@@ -576,6 +607,7 @@ func (s *UserStore) GetWithProfile(ctx context.Context, isDeleted bool, fields [
 			&user.CreatedAt,
 			&user.UpdatedAt,
 			&user.DeletedAt,
+			&user.TokensValidAfter,
 			&user.UserProfile.ID,
 			&user.UserProfile.Username,
 			&user.UserProfile.ProfilePic,
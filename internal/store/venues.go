@@ -0,0 +1,184 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+)
+
+const venueColumns = `
+	id, org_id, name, address, latitude, longitude, capacity,
+	accessibility_notes, version, created_at, updated_at, deleted_at
+`
+
+type VenueStore struct {
+	db *sql.DB
+}
+
+func (s *VenueStore) Create(ctx context.Context, venue *models.Venue) error {
+	query := `
+		INSERT INTO venues(org_id, name, address, latitude, longitude, capacity, accessibility_notes)
+		VALUES($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, version, created_at, updated_at, deleted_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	return s.db.QueryRowContext(
+		ctx,
+		query,
+		venue.OrganizationID,
+		venue.Name,
+		venue.Address,
+		venue.Latitude,
+		venue.Longitude,
+		venue.Capacity,
+		venue.AccessibilityNotes,
+	).Scan(
+		&venue.ID,
+		&venue.Version,
+		&venue.CreatedAt,
+		&venue.UpdatedAt,
+		&venue.DeletedAt,
+	)
+}
+
+func (s *VenueStore) Get(ctx context.Context, isDeleted bool, fields []string, values []any) (*models.Venue, error) {
+	query := fmt.Sprintf("SELECT %s FROM venues WHERE %s", venueColumns, generateQueryConditions(isDeleted, fields))
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	var venue models.Venue
+	err := s.db.QueryRowContext(ctx, query, values...).Scan(
+		&venue.ID,
+		&venue.OrganizationID,
+		&venue.Name,
+		&venue.Address,
+		&venue.Latitude,
+		&venue.Longitude,
+		&venue.Capacity,
+		&venue.AccessibilityNotes,
+		&venue.Version,
+		&venue.CreatedAt,
+		&venue.UpdatedAt,
+		&venue.DeletedAt,
+	)
+
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return nil, ErrNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return &venue, nil
+}
+
+func (s *VenueStore) GetByOrgID(ctx context.Context, orgID int64) ([]*models.SimpleVenue, error) {
+	query := `
+		SELECT id, name, address, latitude, longitude, capacity, accessibility_notes
+		FROM venues
+		WHERE org_id = $1 AND deleted_at IS NULL
+		ORDER BY name ASC, id ASC
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	rows, err := s.db.QueryContext(ctx, query, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var venues []*models.SimpleVenue
+	for rows.Next() {
+		var venue models.SimpleVenue
+		err := rows.Scan(
+			&venue.ID,
+			&venue.Name,
+			&venue.Address,
+			&venue.Latitude,
+			&venue.Longitude,
+			&venue.Capacity,
+			&venue.AccessibilityNotes,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		venues = append(venues, &venue)
+	}
+
+	return venues, nil
+}
+
+func (s *VenueStore) Update(ctx context.Context, venue *models.Venue) error {
+	query := `
+		UPDATE venues
+		SET name = $1, address = $2, latitude = $3, longitude = $4, capacity = $5,
+			accessibility_notes = $6, version = version + 1
+		WHERE id = $7 AND version = $8
+		RETURNING version, updated_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	err := s.db.QueryRowContext(
+		ctx,
+		query,
+		venue.Name,
+		venue.Address,
+		venue.Latitude,
+		venue.Longitude,
+		venue.Capacity,
+		venue.AccessibilityNotes,
+		venue.ID,
+		venue.Version,
+	).Scan(&venue.Version, &venue.UpdatedAt)
+
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *VenueStore) SoftDelete(ctx context.Context, venue *models.Venue) error {
+	query := `
+		UPDATE venues
+		SET deleted_at = $1, version = version + 1
+		WHERE id = $2 AND version = $3
+		RETURNING version, updated_at, deleted_at
+	`
+	ctx, cancel := context.WithTimeout(ctx, QueryTimeoutDuration)
+	defer cancel()
+
+	values := []any{time.Now().UTC().Format(internal.DateTimeFormat), venue.ID, venue.Version}
+	err := s.db.QueryRowContext(ctx, query, values...).Scan(
+		&venue.Version,
+		&venue.UpdatedAt,
+		&venue.DeletedAt,
+	)
+
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ErrNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+var ErrInvalidCursor = errors.New("cursor is invalid")
+
+// EncodeCursor encodes the position of the last item on a page into an
+// opaque string that clients send back to fetch the next page.
+//
+// Parameters:
+//   - value: The position of the last item on the page, usually the values
+//     the items are sorted by. It must be able to be encoded as JSON.
+//
+// Returns:
+//   - A Base64 URL-safe encoded string that represents the cursor.
+//   - An error if the value can not be encoded as JSON.
+//
+// Example usage:
+//
+//	cursor, err := EncodeCursor(EventCursor{StartTime: "2025-03-01T18:00:00Z", ID: 42})
+func EncodeCursor(value any) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// DecodeCursor decodes a cursor created by EncodeCursor into value.
+//
+// Parameters:
+//   - cursor: The cursor sent by the client.
+//   - value: A pointer to the value the cursor is decoded into.
+//
+// Returns:
+//   - ErrInvalidCursor if the cursor was not created by EncodeCursor or does
+//     not hold a value of the same type.
+//
+// Example usage:
+//
+//	var position EventCursor
+//	if err := DecodeCursor(cursor, &position); err != nil {
+//	  // handle the invalid cursor
+//	}
+func DecodeCursor(cursor string, value any) error {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return ErrInvalidCursor
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(value); err != nil {
+		return ErrInvalidCursor
+	}
+
+	return nil
+}
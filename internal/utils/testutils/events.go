@@ -0,0 +1,108 @@
+package testutils
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+)
+
+// GenerateEventTimes returns a start and end time in RFC3339 format for an
+// event that starts `startsIn` from now and lasts for two hours.
+func GenerateEventTimes(startsIn time.Duration) (string, string) {
+	start := time.Now().UTC().Add(startsIn).Truncate(time.Second)
+	end := start.Add(time.Hour * 2)
+
+	return start.Format(internal.DateTimeFormat), end.Format(internal.DateTimeFormat)
+}
+
+func CreateTestEvent(ctx context.Context, appStore store.Store, isDeleted bool, orgID int64) (*models.Event, error) {
+	title := faker.Username(options.WithGenerateUniqueValues(true))
+	startTime, endTime := GenerateEventTimes(time.Hour * 24)
+	event := &models.Event{
+		OrganizationID: orgID,
+		Title:          title,
+		Description:    fmt.Sprintf("%s Description", title),
+		StartTime:      startTime,
+		EndTime:        endTime,
+		Location:       "Kampala, Uganda",
+		Capacity:       10,
+	}
+
+	if err := appStore.Events.Create(ctx, event); err != nil {
+		return nil, err
+	}
+
+	if isDeleted {
+		if err := appStore.Events.SoftDelete(ctx, event); err != nil {
+			return nil, err
+		}
+	}
+
+	return event, nil
+}
+
+func CreateTestPublishedEvent(ctx context.Context, appStore store.Store, isDeleted bool, orgID, userProfileID int64) (*models.Event, error) {
+	event, err := CreateTestEvent(ctx, appStore, false, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := appStore.Events.Publish(ctx, event, userProfileID); err != nil {
+		return nil, err
+	}
+
+	if isDeleted {
+		if err := appStore.Events.SoftDelete(ctx, event); err != nil {
+			return nil, err
+		}
+	}
+
+	return event, nil
+}
+
+func CreateTestRSVP(ctx context.Context, appStore store.Store, eventID, userProfileID int64, status models.RSVPStatus) (*models.EventRSVP, error) {
+	rsvp := &models.EventRSVP{
+		EventID:       eventID,
+		UserProfileID: userProfileID,
+		Status:        status,
+	}
+
+	if err := appStore.EventRSVPs.Create(ctx, rsvp); err != nil {
+		return nil, err
+	}
+
+	return rsvp, nil
+}
+
+// CreateTestRecurringEvent creates a published event that repeats according
+// to rrule, with its first occurrence a day from now.
+func CreateTestRecurringEvent(ctx context.Context, appStore store.Store, orgID, userProfileID int64, rrule string) (*models.Event, error) {
+	title := faker.Username(options.WithGenerateUniqueValues(true))
+	startTime, endTime := GenerateEventTimes(time.Hour * 24)
+	event := &models.Event{
+		OrganizationID: orgID,
+		Title:          title,
+		Description:    fmt.Sprintf("%s Description", title),
+		StartTime:      startTime,
+		EndTime:        endTime,
+		Location:       "Kampala, Uganda",
+		Capacity:       10,
+		RRule:          rrule,
+	}
+
+	if err := appStore.Events.Create(ctx, event); err != nil {
+		return nil, err
+	}
+
+	if err := appStore.Events.Publish(ctx, event, userProfileID); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
@@ -1,10 +1,14 @@
 package testutils
 
 import (
+	"context"
 	"time"
 
+	"github.com/KengoWada/meetup-clone/internal"
 	"github.com/KengoWada/meetup-clone/internal/auth"
 	"github.com/KengoWada/meetup-clone/internal/config"
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/store"
 	"github.com/golang-jwt/jwt/v5"
 )
 
@@ -15,8 +19,22 @@ type ClaimsDetails struct {
 	NotBefore int64
 }
 
-func GenerateTesAuthToken(authenticator auth.Authenticator, cfg config.AuthConfig, isValid bool, ID int64) (string, error) {
+// GenerateTesAuthToken creates an access token for the user the way logging
+// in does, with a new session for its sid claim.
+func GenerateTesAuthToken(ctx context.Context, appStore store.Store, authenticator auth.Authenticator, cfg config.AuthConfig, isValid bool, ID int64) (string, error) {
+	jti, err := auth.GenerateTokenID()
+	if err != nil {
+		return "", err
+	}
+
+	session, err := CreateTestSession(ctx, appStore, ID)
+	if err != nil {
+		return "", err
+	}
+
 	claims := generateJWTClaims(cfg, isValid, ID)
+	claims["jti"] = jti
+	claims["sid"] = session.ID
 	token, err := authenticator.GenerateToken(claims)
 	if err != nil {
 		return "", err
@@ -25,9 +43,36 @@ func GenerateTesAuthToken(authenticator auth.Authenticator, cfg config.AuthConfi
 	return token, nil
 }
 
+// CreateTestSession starts a session for the user along with its first
+// refresh token, which expires in an hour.
+func CreateTestSession(ctx context.Context, appStore store.Store, userID int64) (*models.UserSession, error) {
+	familyID, err := auth.GenerateTokenFamily()
+	if err != nil {
+		return nil, err
+	}
+
+	_, hash, err := auth.GenerateRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken := &models.RefreshToken{
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: hash,
+		ExpiresAt: time.Now().UTC().Add(time.Hour).Format(internal.DateTimeFormat),
+	}
+	session := &models.UserSession{UserID: userID, TokenFamilyID: familyID, Device: "Test"}
+	if err := appStore.Sessions.Create(ctx, session, refreshToken); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
 func generateJWTClaims(cfg config.AuthConfig, isValid bool, ID int64) jwt.MapClaims {
 	var (
-		exp = time.Hour * time.Duration(cfg.Exp)
+		exp = time.Minute * time.Duration(cfg.Exp)
 		iat = time.Now()
 		aud = time.Now()
 	)
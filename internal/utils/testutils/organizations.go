@@ -3,7 +3,9 @@ package testutils
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/KengoWada/meetup-clone/internal"
 	"github.com/KengoWada/meetup-clone/internal/models"
 	"github.com/KengoWada/meetup-clone/internal/store"
 	"github.com/go-faker/faker/v4"
@@ -68,3 +70,38 @@ func CreateTestOrganizationMember(ctx context.Context, appStore store.Store, org
 
 	return member, nil
 }
+
+func CreateTestOrganizationInvite(ctx context.Context, appStore store.Store, isExpired bool, orgID, roleID, userID int64) (*models.OrganizationInvite, error) {
+	expiresAt := time.Now().UTC().Add(time.Hour)
+	if isExpired {
+		expiresAt = time.Now().UTC().Add(-time.Hour)
+	}
+
+	invite := &models.OrganizationInvite{
+		OrganizationID: orgID,
+		RoleID:         roleID,
+		UserProfileID:  &userID,
+		ExpiresAt:      expiresAt.Format(internal.DateTimeFormat),
+	}
+
+	if err := appStore.OrganizationInvites.Create(ctx, invite); err != nil {
+		return nil, err
+	}
+
+	return invite, nil
+}
+
+func CreateTestOrganizationEmailInvite(ctx context.Context, appStore store.Store, orgID, roleID int64, email string) (*models.OrganizationInvite, error) {
+	invite := &models.OrganizationInvite{
+		OrganizationID: orgID,
+		RoleID:         roleID,
+		Email:          &email,
+		ExpiresAt:      time.Now().UTC().Add(time.Hour).Format(internal.DateTimeFormat),
+	}
+
+	if err := appStore.OrganizationInvites.Create(ctx, invite); err != nil {
+		return nil, err
+	}
+
+	return invite, nil
+}
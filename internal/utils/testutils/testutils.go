@@ -177,6 +177,7 @@ func (r *TestRequestResponse) GetData() (map[string]any, bool) {
 // It marshals the provided request data to JSON, sets the appropriate content type,
 // and executes the request against the given HTTP handler (mux). The function then
 // captures the response and unmarshals the JSON response body for easier assertions.
+// Bodies of other content types, such as calendar feeds, are left in the recorder.
 //
 // Parameters:
 //   - mux (http.Handler): The HTTP handler to serve the request (e.g., your router).
@@ -223,9 +224,11 @@ func RunTestRequest(mux http.Handler, method, endpoint string, headers TestReque
 	mux.ServeHTTP(w, r)
 
 	response := make(TestResponseData)
-	err = json.Unmarshal(w.Body.Bytes(), &response)
-	if err != nil {
-		return nil, err
+	if w.Header().Get("Content-Type") == "application/json" {
+		err = json.Unmarshal(w.Body.Bytes(), &response)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &TestRequestResponse{Response: w, ResponseData: response}, nil
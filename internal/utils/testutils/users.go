@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/auth"
 	"github.com/KengoWada/meetup-clone/internal/models"
 	"github.com/KengoWada/meetup-clone/internal/store"
 	"github.com/KengoWada/meetup-clone/internal/utils"
@@ -189,3 +190,39 @@ func GenerateEmailAndUsername() (email string, username string) {
 
 	return name + "@clone.meetup.org", name
 }
+
+// EnableTestTwoFactor enables two-factor authentication for the user with a
+// new TOTP secret, stored encrypted with the key. No codes are marked as
+// used, so a code for the current time is accepted once.
+//
+// Returns:
+//   - secret: The TOTP secret to generate codes from with auth.GenerateTOTP.
+//   - recoveryCodes: The user's one-time recovery codes.
+//   - error: An error if the secret or recovery codes could not be created or saved.
+func EnableTestTwoFactor(ctx context.Context, appStore store.Store, userID int64, key []byte) (string, []string, error) {
+	secret, err := auth.GenerateTOTPSecret()
+	if err != nil {
+		return "", nil, err
+	}
+
+	recoveryCodes, hashes, err := auth.GenerateRecoveryCodes()
+	if err != nil {
+		return "", nil, err
+	}
+
+	encryptedSecret, err := auth.EncryptTOTPSecret(secret, key)
+	if err != nil {
+		return "", nil, err
+	}
+
+	twoFactor := &models.TwoFactor{UserID: userID, Secret: encryptedSecret}
+	if err := appStore.TwoFactor.CreatePending(ctx, twoFactor); err != nil {
+		return "", nil, err
+	}
+
+	if err := appStore.TwoFactor.Enable(ctx, twoFactor, 1, hashes); err != nil {
+		return "", nil, err
+	}
+
+	return secret, recoveryCodes, nil
+}
@@ -0,0 +1,72 @@
+package testutils
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/KengoWada/meetup-clone/internal/models"
+	"github.com/KengoWada/meetup-clone/internal/store"
+	"github.com/go-faker/faker/v4"
+	"github.com/go-faker/faker/v4/pkg/options"
+)
+
+// GenerateCoordinates returns a random latitude and longitude away from the
+// poles and the antimeridian. Tests that search for events near a location
+// use it so that they do not find the events created by other tests.
+func GenerateCoordinates() (float64, float64) {
+	return rand.Float64()*120 - 60, rand.Float64()*340 - 170
+}
+
+func CreateTestVenue(ctx context.Context, appStore store.Store, isDeleted bool, orgID int64, latitude, longitude float64) (*models.Venue, error) {
+	name := faker.Username(options.WithGenerateUniqueValues(true))
+	venue := &models.Venue{
+		OrganizationID:     orgID,
+		Name:               name,
+		Address:            fmt.Sprintf("%s Street, Kampala, Uganda", name),
+		Latitude:           latitude,
+		Longitude:          longitude,
+		Capacity:           50,
+		AccessibilityNotes: "Step free access",
+	}
+
+	if err := appStore.Venues.Create(ctx, venue); err != nil {
+		return nil, err
+	}
+
+	if isDeleted {
+		if err := appStore.Venues.SoftDelete(ctx, venue); err != nil {
+			return nil, err
+		}
+	}
+
+	return venue, nil
+}
+
+// CreateTestVenueEvent creates a published event held at the venue that
+// starts `startsIn` from now.
+func CreateTestVenueEvent(ctx context.Context, appStore store.Store, orgID, userProfileID, venueID int64, startsIn time.Duration) (*models.Event, error) {
+	title := faker.Username(options.WithGenerateUniqueValues(true))
+	startTime, endTime := GenerateEventTimes(startsIn)
+	event := &models.Event{
+		OrganizationID: orgID,
+		Title:          title,
+		Description:    fmt.Sprintf("%s Description", title),
+		StartTime:      startTime,
+		EndTime:        endTime,
+		Location:       "Kampala, Uganda",
+		VenueID:        &venueID,
+		Capacity:       10,
+	}
+
+	if err := appStore.Events.Create(ctx, event); err != nil {
+		return nil, err
+	}
+
+	if err := appStore.Events.Publish(ctx, event, userProfileID); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
@@ -9,12 +9,39 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
+	"math"
+	"strings"
 	"time"
 
 	"github.com/KengoWada/meetup-clone/internal"
 )
 
-var ErrExpiredToken = errors.New("token has expired")
+var (
+	ErrExpiredToken = errors.New("token has expired")
+	ErrInvalidToken = errors.New("token is invalid")
+)
+
+// NoExpiry is passed as the expiration duration when validating tokens that
+// do not expire on their own. Such tokens stop working once the record they
+// are bound to is revoked, deleted or expires instead.
+const NoExpiry = time.Duration(math.MaxInt64)
+
+// Purposes tokens can be issued for. The purpose is prefixed to the body of
+// the token, so a token issued for one purpose is rejected when it is used
+// for another.
+const (
+	TokenPurposeTicket       = "ticket"
+	TokenPurposeJoinLink     = "join_link"
+	TokenPurposeCalendarFeed = "calendar_feed"
+
+	// TokenPurposeTwoFactorSecret is used to encrypt TOTP secrets before
+	// they are stored rather than to hand out a token.
+	TokenPurposeTwoFactorSecret = "two_factor_secret"
+)
+
+// tokenPurposeSeparator separates the purpose from the data in the body of
+// a token.
+const tokenPurposeSeparator = ":"
 
 // TimedTokenData represents the data structure used for storing the content
 // and timestamp of a token. The struct contains information about when
@@ -113,6 +140,10 @@ func ValidateToken(token string, key []byte, expiresIn time.Duration) (*TimedTok
 	}
 
 	nonceSize := gcm.NonceSize()
+	if len(ciphered) < nonceSize {
+		return nil, ErrInvalidToken
+	}
+
 	nonce, cipheredText := ciphered[:nonceSize], ciphered[nonceSize:]
 
 	originalText, err := gcm.Open(nil, nonce, cipheredText, nil)
@@ -139,6 +170,51 @@ func ValidateToken(token string, key []byte, expiresIn time.Duration) (*TimedTok
 	return &timedTokenData, nil
 }
 
+// GeneratePurposeToken creates a token like GenerateToken, with the purpose
+// prefixed to the data in its body.
+//
+// Parameters:
+//   - purpose: What the token is issued for, e.g. TokenPurposeTicket.
+//   - data: The string data to be encrypted.
+//   - key: The secret key used for encryption.
+//
+// Returns:
+//   - A Base64 URL-safe encoded string that represents the encrypted token.
+//   - An error if there is any issue during the encryption or encoding process.
+func GeneratePurposeToken(purpose, data string, key []byte) (string, error) {
+	return GenerateToken(purpose+tokenPurposeSeparator+data, key)
+}
+
+// ValidatePurposeToken validates a token like ValidateToken and checks that
+// it was issued by GeneratePurposeToken for the given purpose. The purpose
+// prefix is removed from the body of the returned token data.
+//
+// Parameters:
+//   - token: The token string to be validated.
+//   - purpose: What the token must have been issued for.
+//   - key: The secret key used to decrypt the token.
+//   - expiresIn: The duration after which the token is considered expired.
+//
+// Returns:
+//   - A pointer to a `TimedTokenData` struct containing the `createdAt` timestamp
+//     and the `body` of the token without its purpose.
+//   - ErrInvalidToken if the token was issued for another purpose, or any error
+//     returned by ValidateToken.
+func ValidatePurposeToken(token, purpose string, key []byte, expiresIn time.Duration) (*TimedTokenData, error) {
+	timedTokenData, err := ValidateToken(token, key, expiresIn)
+	if err != nil {
+		return nil, err
+	}
+
+	body, ok := strings.CutPrefix(timedTokenData.Body, purpose+tokenPurposeSeparator)
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+	timedTokenData.Body = body
+
+	return timedTokenData, nil
+}
+
 // getBlockCipher creates a new AES-GCM block cipher using the provided key.
 // The key is first hashed using SHA-256 to create a 32-byte key suitable for AES encryption.
 // The resulting block cipher is returned as an AEAD (Authenticated Encryption with
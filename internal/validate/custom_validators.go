@@ -7,6 +7,7 @@ import (
 	"unicode"
 
 	"github.com/KengoWada/meetup-clone/internal"
+	"github.com/KengoWada/meetup-clone/internal/rrule"
 	"github.com/go-playground/validator/v10"
 )
 
@@ -18,6 +19,13 @@ func dateValidator(fl validator.FieldLevel) bool {
 	return err == nil
 }
 
+// dateTimeValidator is a custom validation function that checks if the
+// date time provided matches the RFC3339 format e.g. `2006-01-02T15:04:05Z07:00`.
+func dateTimeValidator(fl validator.FieldLevel) bool {
+	_, err := time.Parse(internal.DateTimeFormat, fl.Field().String())
+	return err == nil
+}
+
 // passwordValidator is a custom validation function that checks if a password
 // meets the following criteria:
 // 1. Contains at least one uppercase letter.
@@ -71,6 +79,13 @@ func orgNameValidator(fl validator.FieldLevel) bool {
 	return r.MatchString(fl.Field().String())
 }
 
+// rruleValidator is a custom validation function that checks if the value
+// is a recurrence rule that the rrule package can expand.
+func rruleValidator(fl validator.FieldLevel) bool {
+	_, err := rrule.Parse(fl.Field().String())
+	return err == nil
+}
+
 func permissionValidator(fl validator.FieldLevel) bool {
 	return slices.Contains(internal.Permissions, fl.Field().String())
 }
@@ -25,6 +25,8 @@ var (
 
 	TagErrorsDOB = TagErrorMessages{"is_date": "Invalid date format. mm/dd/yyyy"}
 
+	TagErrorsDateTime = TagErrorMessages{"is_datetime": "Invalid date time format. yyyy-mm-ddThh:mm:ssZ"}
+
 	TagErrorsUsername = TagErrorMessages{
 		"min": "Username must have at least 3 characters",
 		"max": "Username must have at most 100 characters",
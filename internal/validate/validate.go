@@ -17,9 +17,11 @@ func Get() *validator.Validate {
 	once.Do(func() {
 		Validate = validator.New(validator.WithRequiredStructEnabled())
 		Validate.RegisterValidation("is_date", dateValidator)
+		Validate.RegisterValidation("is_datetime", dateTimeValidator)
 		Validate.RegisterValidation("is_password", passwordValidator)
 		Validate.RegisterValidation("is_org_name", orgNameValidator)
 		Validate.RegisterValidation("is_permission", permissionValidator)
+		Validate.RegisterValidation("is_rrule", rruleValidator)
 	})
 
 	return Validate